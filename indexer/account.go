@@ -13,33 +13,74 @@ var (
 	ErrNoAccountsToIndex = errors.New("no accounts to index")
 )
 
-func convertProviderAccountToAccount(height int, providerAccount *provider.GetAccountOutput) *types.Account {
-	var balanceDenomination string
+func convertProviderAccountToAccount(conv *converter, providerAccount *provider.GetAccountOutput) *types.Account {
+	var balances []*types.Coin
 	balance := new(big.Int)
+	source := conversionSource{entity: accountEntity, address: providerAccount.Address}
 
-	if len(providerAccount.Coins) == 1 {
-		coins := providerAccount.Coins[0]
-		balance, _ = balance.SetString(coins.Amount, 10)
-		balanceDenomination = coins.Denom
+	for _, providerCoin := range providerAccount.Coins {
+		coin := &types.Coin{
+			Denomination: providerCoin.Denom,
+			Amount:       conv.parseBigInt(source, "coins.amount", providerCoin.Amount),
+		}
+
+		if coin.Denomination == types.NativeDenomination {
+			balance = coin.Amount
+		}
+
+		balances = append(balances, coin)
 	}
 
 	return &types.Account{
 		Address:             providerAccount.Address,
-		Height:              height,
+		Height:              conv.height,
 		Balance:             balance,
-		BalanceDenomination: balanceDenomination,
+		BalanceDenomination: types.NativeDenomination,
+		Balances:            balances,
 	}
 }
 
 // IndexAccounts converts accounts details to known structures and saved them
 // returns all addresses indexed
 func (i *Indexer) IndexAccounts(blockHeight int) ([]string, error) {
+	conv := newConverter(blockHeight)
+
+	accounts, err := i.getAccounts(conv)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accounts) == 0 {
+		return nil, ErrNoAccountsToIndex
+	}
+
+	var addresses []string
+
+	for _, account := range accounts {
+		addresses = append(addresses, account.Address)
+	}
+
+	err = i.checkConversion(conv)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.driver.WriteAccounts(accounts)
+	if err != nil {
+		return addresses, err
+	}
+
+	return addresses, i.recordConversionWarnings(conv)
+}
+
+// getAccounts returns the accounts of the converter height
+func (i *Indexer) getAccounts(conv *converter) ([]*types.Account, error) {
 	totalPages := 1
-	var providerAccounts []*provider.GetAccountOutput
+	var accounts []*types.Account
 
 	for page := 1; page <= totalPages; page++ {
 		accountsOutput, err := i.provider.GetAccounts(&provider.GetAccountsOptions{
-			Height:  blockHeight,
+			Height:  conv.height,
 			Page:    page,
 			PerPage: 10000,
 		})
@@ -51,20 +92,10 @@ func (i *Indexer) IndexAccounts(blockHeight int) ([]string, error) {
 			totalPages = accountsOutput.TotalPages
 		}
 
-		providerAccounts = append(providerAccounts, accountsOutput.Result...)
-	}
-
-	if len(providerAccounts) == 0 {
-		return nil, ErrNoAccountsToIndex
-	}
-
-	var accounts []*types.Account
-	var addresses []string
-
-	for _, account := range providerAccounts {
-		accounts = append(accounts, convertProviderAccountToAccount(blockHeight, account))
-		addresses = append(addresses, account.Address)
+		for _, account := range accountsOutput.Result {
+			accounts = append(accounts, convertProviderAccountToAccount(conv, account))
+		}
 	}
 
-	return addresses, i.driver.WriteAccounts(accounts)
+	return accounts, nil
 }
@@ -1,13 +1,16 @@
 package indexer
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/pokt-foundation/pocket-go/provider"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
 	"github.com/pokt-foundation/utils-go/mock-client"
 	testMock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -56,3 +59,24 @@ func TestIndexer_IndexAccounts(t *testing.T) {
 	c.Len(addresses, 1)
 	c.Equal("98a18a38aa6826a55dccce19f607e3171cf14366", addresses[0])
 }
+
+func TestConvertProviderAccountToAccount(t *testing.T) {
+	c := require.New(t)
+
+	var providerAccount provider.GetAccountOutput
+
+	c.NoError(json.Unmarshal([]byte(`{"address":"98a18a38aa6826a55dccce19f607e3171cf14366",
+		"coins":[{"amount":"21","denom":"uwpokt"},{"amount":"212121","denom":"upokt"}]}`), &providerAccount))
+
+	account := convertProviderAccountToAccount(newConverter(21), &providerAccount)
+	c.Equal(big.NewInt(212121), account.Balance)
+	c.Equal(types.NativeDenomination, account.BalanceDenomination)
+	c.Len(account.Balances, 2)
+
+	c.NoError(json.Unmarshal([]byte(`{"address":"98a18a38aa6826a55dccce19f607e3171cf14366",
+		"coins":[{"amount":"21","denom":"uwpokt"}]}`), &providerAccount))
+
+	account = convertProviderAccountToAccount(newConverter(21), &providerAccount)
+	c.Equal(big.NewInt(0), account.Balance)
+	c.Equal(types.NativeDenomination, account.BalanceDenomination)
+}
@@ -2,7 +2,6 @@ package indexer
 
 import (
 	"errors"
-	"math/big"
 
 	"github.com/pokt-foundation/pocket-go/provider"
 	"github.com/pokt-foundation/pocket-indexer-lib/types"
@@ -13,28 +12,62 @@ var (
 	ErrNoAppsToIndex = errors.New("no apps to index")
 )
 
-func convertProviderAppToApp(height int, provApp *provider.App) *types.App {
-	stakedTokens := new(big.Int)
-	stakedTokens, _ = stakedTokens.SetString(provApp.StakedTokens, 10)
+func convertProviderAppToApp(conv *converter, provApp *provider.App) *types.App {
+	source := conversionSource{entity: appEntity, address: provApp.Address}
 
 	return &types.App{
-		Address:      provApp.Address,
-		Height:       height,
-		Jailed:       provApp.Jailed,
-		PublicKey:    provApp.PublicKey,
-		StakedTokens: stakedTokens,
+		Address:       provApp.Address,
+		Height:        conv.height,
+		Jailed:        provApp.Jailed,
+		PublicKey:     provApp.PublicKey,
+		StakedTokens:  conv.parseBigInt(source, "staked_tokens", provApp.StakedTokens),
+		Chains:        provApp.Chains,
+		MaxRelays:     conv.parseBigInt(source, "max_relays", provApp.MaxRelays),
+		UnstakingTime: provApp.UnstakingTime,
 	}
 }
 
 // IndexBlockApps converts apps details to known structures and saved them
 // returns all addresses indexed
 func (i *Indexer) IndexBlockApps(blockHeight int) ([]string, error) {
+	conv := newConverter(blockHeight)
+
+	apps, err := i.getApps(conv)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(apps) == 0 {
+		return nil, ErrNoAppsToIndex
+	}
+
+	var addresses []string
+
+	for _, app := range apps {
+		addresses = append(addresses, app.Address)
+	}
+
+	err = i.checkConversion(conv)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.driver.WriteApps(apps)
+	if err != nil {
+		return addresses, err
+	}
+
+	return addresses, i.recordConversionWarnings(conv)
+}
+
+// getApps returns the apps of the converter height
+func (i *Indexer) getApps(conv *converter) ([]*types.App, error) {
 	totalPages := 1
-	var providerApps []*provider.App
+	var apps []*types.App
 
 	for page := 1; page <= totalPages; page++ {
 		appsOutput, err := i.provider.GetApps(&provider.GetAppsOptions{
-			Height:  blockHeight,
+			Height:  conv.height,
 			Page:    page,
 			PerPage: 10000,
 		})
@@ -46,20 +79,10 @@ func (i *Indexer) IndexBlockApps(blockHeight int) ([]string, error) {
 			totalPages = appsOutput.TotalPages
 		}
 
-		providerApps = append(providerApps, appsOutput.Result...)
-	}
-
-	if len(providerApps) == 0 {
-		return nil, ErrNoAppsToIndex
-	}
-
-	var apps []*types.App
-	var addresses []string
-
-	for _, app := range providerApps {
-		apps = append(apps, convertProviderAppToApp(blockHeight, app))
-		addresses = append(addresses, app.Address)
+		for _, app := range appsOutput.Result {
+			apps = append(apps, convertProviderAppToApp(conv, app))
+		}
 	}
 
-	return addresses, i.driver.WriteApps(apps)
+	return apps, nil
 }
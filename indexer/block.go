@@ -2,7 +2,6 @@ package indexer
 
 import (
 	"errors"
-	"strconv"
 	"time"
 
 	"github.com/pokt-foundation/pocket-go/provider"
@@ -12,14 +11,38 @@ import (
 var (
 	// ErrBlockHasNoHash error when block hash no hash
 	ErrBlockHasNoHash = errors.New("block to index has no hash")
+	// ErrNetworkStatsNotSupported error when the driver does not maintain network stats
+	ErrNetworkStatsNotSupported = errors.New("driver does not support network stats")
 )
 
-func convertProviderBlockToBlock(providerBlock *provider.GetBlockOutput) *types.Block {
+// partitionDriver is implemented by drivers that partition the per height tables
+type partitionDriver interface {
+	CreateHeightPartitions(height int) error
+}
+
+// supplyDriver is implemented by drivers that track the supply per height
+type supplyDriver interface {
+	GetSupply(height int) (*types.Supply, error)
+	WriteSupply(supply *types.Supply) error
+}
+
+// networkStatsDriver is implemented by drivers that maintain the network stats rollups
+type networkStatsDriver interface {
+	WriteNetworkStats(height int) error
+}
+
+// notifyDriver is implemented by drivers that notify the indexed heights to their subscribers
+type notifyDriver interface {
+	NotifyHeight(height int) error
+}
+
+func convertProviderBlockToBlock(conv *converter, providerBlock *provider.GetBlockOutput) *types.Block {
 	blockHeader := providerBlock.Block.Header
+	source := conversionSource{entity: blockEntity, hash: providerBlock.BlockID.Hash}
 
-	height, _ := strconv.Atoi(blockHeader.Height)
-	countTx, _ := strconv.Atoi(blockHeader.NumTxs)
-	totalTxs, _ := strconv.Atoi(blockHeader.TotalTxs)
+	height := conv.parseInt(source, "height", blockHeader.Height)
+	countTx := conv.parseInt(source, "num_txs", blockHeader.NumTxs)
+	totalTxs := conv.parseInt(source, "total_txs", blockHeader.TotalTxs)
 
 	return &types.Block{
 		Hash:            providerBlock.BlockID.Hash,
@@ -32,23 +55,77 @@ func convertProviderBlockToBlock(providerBlock *provider.GetBlockOutput) *types.
 }
 
 // IndexBlock converts block details to a known structure and saves them
+// if the driver partitions the per height tables, partitions for the height are created first
+// on strict conversion mode malformed block fields return a ConversionError
 func (i *Indexer) IndexBlock(blockHeight int) error {
-	blockOutput, err := i.provider.GetBlock(blockHeight)
+	conv := newConverter(blockHeight)
+
+	block, err := i.getBlock(conv)
 	if err != nil {
 		return err
 	}
 
+	err = i.checkConversion(conv)
+	if err != nil {
+		return err
+	}
+
+	err = i.createHeightPartitions(blockHeight)
+	if err != nil {
+		return err
+	}
+
+	err = i.driver.WriteBlock(block)
+	if err != nil {
+		return err
+	}
+
+	return i.recordConversionWarnings(conv)
+}
+
+// getBlock returns the block of the converter height
+func (i *Indexer) getBlock(conv *converter) (*types.Block, error) {
+	blockOutput, err := i.provider.GetBlock(conv.height)
+	if err != nil {
+		return nil, err
+	}
+
 	if blockOutput.BlockID.Hash == "" {
-		return ErrBlockHasNoHash
+		return nil, ErrBlockHasNoHash
+	}
+
+	return convertProviderBlockToBlock(conv, blockOutput), nil
+}
+
+// createHeightPartitions creates the partitions for the height if the driver partitions the per height tables
+func (i *Indexer) createHeightPartitions(blockHeight int) error {
+	partDriver, ok := i.driver.(partitionDriver)
+	if !ok {
+		return nil
 	}
 
-	return i.driver.WriteBlock(convertProviderBlockToBlock(blockOutput))
+	return partDriver.CreateHeightPartitions(blockHeight)
 }
 
 // IndexBlockCalculatedFields indexes calculated fields for block in given height
-// Calculated fields are accounts, apps and nodes quantities and took
+// Calculated fields are accounts, apps and nodes quantities, took, the height supply if the driver tracks it
+// and the network stats if the driver maintains them
+// they are the last values written for a height, so the height is notified afterwards if the driver supports it
 // getTook input is necessary for custom indexing (first height won't have the previous block to calculate took value)
 func (i *Indexer) IndexBlockCalculatedFields(blockHeight int, getTook bool) error {
+	getDuration := func(int) (time.Duration, error) {
+		return 0, nil
+	}
+
+	if getTook {
+		getDuration = i.getDuration
+	}
+
+	return i.indexCalculatedFields(blockHeight, getDuration)
+}
+
+// indexCalculatedFields indexes the calculated fields of the height with the took returned by getDuration
+func (i *Indexer) indexCalculatedFields(blockHeight int, getDuration func(blockHeight int) (time.Duration, error)) error {
 	accountsQuantity, err := i.driver.GetAccountsQuantity(&types.GetAccountsQuantityOptions{
 		Height: blockHeight,
 	})
@@ -70,22 +147,79 @@ func (i *Indexer) IndexBlockCalculatedFields(blockHeight int, getTook bool) erro
 		return err
 	}
 
-	var took time.Duration
-
-	if getTook {
-		took, err = i.getDuration(blockHeight)
-		if err != nil {
-			return err
-		}
+	took, err := getDuration(blockHeight)
+	if err != nil {
+		return err
 	}
 
-	return i.driver.WriteBlockCalculatedFields(&types.Block{
+	err = i.driver.WriteBlockCalculatedFields(&types.Block{
 		Height:           blockHeight,
 		AccountsQuantity: int(accountsQuantity),
 		AppsQuantity:     int(appsQuantity),
 		NodesQuantity:    int(nodesQuantity),
 		Took:             took,
 	})
+	if err != nil {
+		return err
+	}
+
+	err = i.indexSupply(blockHeight)
+	if err != nil {
+		return err
+	}
+
+	err = i.indexNetworkStats(blockHeight)
+	if err != nil {
+		return err
+	}
+
+	return i.notifyHeight(blockHeight)
+}
+
+// notifyHeight notifies the height is indexed if the driver supports it
+func (i *Indexer) notifyHeight(blockHeight int) error {
+	notifier, ok := i.driver.(notifyDriver)
+	if !ok {
+		return nil
+	}
+
+	return notifier.NotifyHeight(blockHeight)
+}
+
+// indexSupply writes the supply of the height if the driver tracks it
+func (i *Indexer) indexSupply(blockHeight int) error {
+	supDriver, ok := i.driver.(supplyDriver)
+	if !ok {
+		return nil
+	}
+
+	supply, err := supDriver.GetSupply(blockHeight)
+	if err != nil {
+		return err
+	}
+
+	return supDriver.WriteSupply(supply)
+}
+
+// IndexNetworkStats updates the hourly and daily network stats with the block and transactions of given height
+// block and transactions of the height need to be indexed first, IndexBlockCalculatedFields already updates them
+// returns ErrNetworkStatsNotSupported if the driver does not maintain network stats
+func (i *Indexer) IndexNetworkStats(blockHeight int) error {
+	statsDriver, ok := i.driver.(networkStatsDriver)
+	if !ok {
+		return ErrNetworkStatsNotSupported
+	}
+
+	return statsDriver.WriteNetworkStats(blockHeight)
+}
+
+// indexNetworkStats updates the network stats with the height if the driver maintains them
+func (i *Indexer) indexNetworkStats(blockHeight int) error {
+	if _, ok := i.driver.(networkStatsDriver); !ok {
+		return nil
+	}
+
+	return i.IndexNetworkStats(blockHeight)
 }
 
 func (i *Indexer) getDuration(blockHeight int) (time.Duration, error) {
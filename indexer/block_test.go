@@ -53,6 +53,45 @@ func TestIndexer_IndexBlock(t *testing.T) {
 	c.NoError(err)
 }
 
+type partitionDriverMock struct {
+	driverMock
+}
+
+func (d *partitionDriverMock) CreateHeightPartitions(height int) error {
+	args := d.Called(height)
+
+	return args.Error(0)
+}
+
+func TestIndexer_IndexBlockWithPartitions(t *testing.T) {
+	c := require.New(t)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	reqProvider := provider.NewProvider("https://dummy.com", []string{})
+
+	driverMock := &partitionDriverMock{}
+
+	indexer := NewIndexer(reqProvider, driverMock)
+
+	mock.AddMockedResponseFromFile(http.MethodPost, fmt.Sprintf("%s%s", "https://dummy.com", provider.QueryBlockRoute),
+		http.StatusOK, "../samples/query_block.json")
+
+	driverMock.On("CreateHeightPartitions", 30363).Return(errors.New("forced failure")).Once()
+
+	err := indexer.IndexBlock(30363)
+	c.EqualError(err, "forced failure")
+
+	driverMock.On("CreateHeightPartitions", 30363).Return(nil).Once()
+	driverMock.On("WriteBlock", testMock.Anything).Return(nil).Once()
+
+	err = indexer.IndexBlock(30363)
+	c.NoError(err)
+
+	driverMock.AssertExpectations(t)
+}
+
 func TestIndexer_IndexBlockWithCalculatedFields(t *testing.T) {
 	c := require.New(t)
 
@@ -109,3 +148,152 @@ func TestIndexer_IndexBlockWithCalculatedFields(t *testing.T) {
 	err = indexer.IndexBlockCalculatedFields(1, true)
 	c.NoError(err)
 }
+
+type supplyDriverMock struct {
+	driverMock
+}
+
+func (d *supplyDriverMock) GetSupply(height int) (*types.Supply, error) {
+	args := d.Called(height)
+
+	return args.Get(0).(*types.Supply), args.Error(1)
+}
+
+func (d *supplyDriverMock) WriteSupply(supply *types.Supply) error {
+	args := d.Called(supply)
+
+	return args.Error(0)
+}
+
+func TestIndexer_IndexBlockCalculatedFieldsWithSupply(t *testing.T) {
+	c := require.New(t)
+
+	reqProvider := provider.NewProvider("https://dummy.com", []string{})
+
+	driverMock := &supplyDriverMock{}
+
+	indexer := NewIndexer(reqProvider, driverMock)
+
+	driverMock.On("GetAccountsQuantity", testMock.Anything).Return(int64(21), nil)
+	driverMock.On("GetAppsQuantity", testMock.Anything).Return(int64(21), nil)
+	driverMock.On("GetNodesQuantity", testMock.Anything).Return(int64(21), nil)
+	driverMock.On("WriteBlockCalculatedFields", testMock.Anything).Return(nil)
+	driverMock.On("GetSupply", 1).Return(&types.Supply{}, errors.New("error on supply")).Once()
+
+	err := indexer.IndexBlockCalculatedFields(1, false)
+	c.EqualError(err, "error on supply")
+
+	driverMock.On("GetSupply", 1).Return(&types.Supply{Height: 1}, nil)
+	driverMock.On("WriteSupply", &types.Supply{Height: 1}).Return(errors.New("error on writing supply")).Once()
+
+	err = indexer.IndexBlockCalculatedFields(1, false)
+	c.EqualError(err, "error on writing supply")
+
+	driverMock.On("WriteSupply", &types.Supply{Height: 1}).Return(nil).Once()
+
+	err = indexer.IndexBlockCalculatedFields(1, false)
+	c.NoError(err)
+
+	driverMock.AssertExpectations(t)
+}
+
+type networkStatsDriverMock struct {
+	driverMock
+}
+
+func (d *networkStatsDriverMock) WriteNetworkStats(height int) error {
+	args := d.Called(height)
+
+	return args.Error(0)
+}
+
+func TestIndexer_IndexNetworkStats(t *testing.T) {
+	c := require.New(t)
+
+	reqProvider := provider.NewProvider("https://dummy.com", []string{})
+
+	indexer := NewIndexer(reqProvider, &driverMock{})
+
+	err := indexer.IndexNetworkStats(30363)
+	c.Equal(ErrNetworkStatsNotSupported, err)
+
+	driverMock := &networkStatsDriverMock{}
+
+	indexer = NewIndexer(reqProvider, driverMock)
+
+	driverMock.On("WriteNetworkStats", 30363).Return(errors.New("forced failure")).Once()
+
+	err = indexer.IndexNetworkStats(30363)
+	c.EqualError(err, "forced failure")
+
+	driverMock.On("WriteNetworkStats", 30363).Return(nil).Once()
+
+	err = indexer.IndexNetworkStats(30363)
+	c.NoError(err)
+}
+
+func TestIndexer_IndexBlockCalculatedFieldsWithNetworkStats(t *testing.T) {
+	c := require.New(t)
+
+	driverMock := &networkStatsDriverMock{}
+
+	indexer := NewIndexer(provider.NewProvider("https://dummy.com", []string{}), driverMock)
+
+	driverMock.On("GetAccountsQuantity", testMock.Anything).Return(int64(21), nil)
+	driverMock.On("GetAppsQuantity", testMock.Anything).Return(int64(21), nil)
+	driverMock.On("GetNodesQuantity", testMock.Anything).Return(int64(21), nil)
+	driverMock.On("WriteBlockCalculatedFields", testMock.Anything).Return(nil)
+	driverMock.On("WriteNetworkStats", 1).Return(errors.New("error on stats")).Once()
+
+	err := indexer.IndexBlockCalculatedFields(1, false)
+	c.EqualError(err, "error on stats")
+
+	driverMock.On("WriteNetworkStats", 1).Return(nil).Once()
+
+	err = indexer.IndexBlockCalculatedFields(1, false)
+	c.NoError(err)
+
+	driverMock.AssertExpectations(t)
+}
+
+type notifyDriverMock struct {
+	networkStatsDriverMock
+}
+
+func (d *notifyDriverMock) NotifyHeight(height int) error {
+	args := d.Called(height)
+
+	return args.Error(0)
+}
+
+func TestIndexer_IndexBlockCalculatedFieldsNotifiesHeight(t *testing.T) {
+	c := require.New(t)
+
+	driverMock := &notifyDriverMock{}
+
+	indexer := NewIndexer(provider.NewProvider("https://dummy.com", []string{}), driverMock)
+
+	driverMock.On("GetAccountsQuantity", testMock.Anything).Return(int64(21), nil)
+	driverMock.On("GetAppsQuantity", testMock.Anything).Return(int64(21), nil)
+	driverMock.On("GetNodesQuantity", testMock.Anything).Return(int64(21), nil)
+	driverMock.On("WriteBlockCalculatedFields", testMock.Anything).Return(nil)
+	driverMock.On("WriteNetworkStats", 1).Return(errors.New("error on stats")).Once()
+
+	// the height is not notified until all its values are written
+	err := indexer.IndexBlockCalculatedFields(1, false)
+	c.EqualError(err, "error on stats")
+	driverMock.AssertNotCalled(t, "NotifyHeight", 1)
+
+	driverMock.On("WriteNetworkStats", 1).Return(nil)
+	driverMock.On("NotifyHeight", 1).Return(errors.New("error on notify")).Once()
+
+	err = indexer.IndexBlockCalculatedFields(1, false)
+	c.EqualError(err, "error on notify")
+
+	driverMock.On("NotifyHeight", 1).Return(nil).Once()
+
+	err = indexer.IndexBlockCalculatedFields(1, false)
+	c.NoError(err)
+
+	driverMock.AssertExpectations(t)
+}
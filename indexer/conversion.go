@@ -0,0 +1,181 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	blockEntity       = "block"
+	transactionEntity = "transaction"
+	accountEntity     = "account"
+	nodeEntity        = "node"
+	appEntity         = "app"
+)
+
+var (
+	// ErrInvalidNumber error when a provider field is not a valid number
+	ErrInvalidNumber = errors.New("invalid number")
+	// ErrMissingField error when a required provider field is empty
+	ErrMissingField = errors.New("missing field")
+	// ErrInvalidPublicKey error when a provider field is not a valid public key
+	ErrInvalidPublicKey = errors.New("invalid public key")
+	// ErrInvalidConversionMode error when given conversion mode is not supported
+	ErrInvalidConversionMode = errors.New("invalid conversion mode")
+)
+
+// ConversionMode enum of how malformed provider data is handled
+type ConversionMode string
+
+const (
+	// LenientConversionMode indexes malformed fields as zero values
+	// and records them as conversion warnings when the driver supports it
+	LenientConversionMode ConversionMode = "lenient"
+	// StrictConversionMode stops indexing the height with a ConversionError on malformed fields
+	StrictConversionMode ConversionMode = "strict"
+)
+
+// conversionWarningsDriver is implemented by drivers that record conversion warnings
+type conversionWarningsDriver interface {
+	WriteConversionWarnings(warnings []*types.ConversionWarning) error
+}
+
+// ConversionError error naming the malformed field of the provider data
+type ConversionError struct {
+	Height int
+	// Entity is the kind of the converted data: block, transaction, account, node or app
+	Entity string
+	Field  string
+	Value  string
+	// Hash is set for blocks and transactions, Address for accounts, nodes and apps
+	Hash    string
+	Address string
+	Err     error
+}
+
+func (e *ConversionError) Error() string {
+	source := e.Hash
+	if source == "" {
+		source = e.Address
+	}
+
+	return fmt.Sprintf("%s %s %s at height %d: %s: %q", e.Entity, source, e.Field, e.Height, e.Err, e.Value)
+}
+
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ConversionError) toWarning() *types.ConversionWarning {
+	return &types.ConversionWarning{
+		Height:  e.Height,
+		Entity:  e.Entity,
+		Field:   e.Field,
+		Value:   e.Value,
+		Hash:    e.Hash,
+		Address: e.Address,
+		Message: e.Err.Error(),
+	}
+}
+
+// conversionSource identifies the provider data being converted
+type conversionSource struct {
+	entity  string
+	hash    string
+	address string
+}
+
+// converter collects the malformed fields found converting the provider data of a height
+type converter struct {
+	height int
+	errs   []*ConversionError
+}
+
+func newConverter(height int) *converter {
+	return &converter{height: height}
+}
+
+func (c *converter) addError(source conversionSource, field, value string, err error) {
+	c.errs = append(c.errs, &ConversionError{
+		Height:  c.height,
+		Entity:  source.entity,
+		Field:   field,
+		Value:   value,
+		Hash:    source.hash,
+		Address: source.address,
+		Err:     err,
+	})
+}
+
+// parseInt returns the int value of field, malformed values are collected and returned as zero
+func (c *converter) parseInt(source conversionSource, field, value string) int {
+	if value == "" {
+		c.addError(source, field, value, ErrMissingField)
+		return 0
+	}
+
+	intValue, err := strconv.Atoi(value)
+	if err != nil {
+		c.addError(source, field, value, ErrInvalidNumber)
+		return 0
+	}
+
+	return intValue
+}
+
+// parseBigInt returns the big.Int value of field, malformed values are collected and returned as zero
+// the returned value is never nil
+func (c *converter) parseBigInt(source conversionSource, field, value string) *big.Int {
+	if value == "" {
+		c.addError(source, field, value, ErrMissingField)
+		return new(big.Int)
+	}
+
+	bigValue, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		c.addError(source, field, value, ErrInvalidNumber)
+		return new(big.Int)
+	}
+
+	return bigValue
+}
+
+// SetConversionMode sets how malformed provider data is handled, default is lenient
+func (i *Indexer) SetConversionMode(mode ConversionMode) error {
+	if mode != LenientConversionMode && mode != StrictConversionMode {
+		return fmt.Errorf("%w: %q", ErrInvalidConversionMode, mode)
+	}
+
+	i.conversionMode = mode
+
+	return nil
+}
+
+// checkConversion returns the first conversion error on strict mode
+func (i *Indexer) checkConversion(conv *converter) error {
+	if i.conversionMode == StrictConversionMode && len(conv.errs) > 0 {
+		return conv.errs[0]
+	}
+
+	return nil
+}
+
+// recordConversionWarnings writes the conversion errors as warnings when the driver supports it
+func (i *Indexer) recordConversionWarnings(conv *converter) error {
+	warningsDriver, ok := i.driver.(conversionWarningsDriver)
+	if !ok || len(conv.errs) == 0 {
+		return nil
+	}
+
+	var warnings []*types.ConversionWarning
+
+	for _, err := range conv.errs {
+		warnings = append(warnings, err.toWarning())
+	}
+
+	return warningsDriver.WriteConversionWarnings(warnings)
+}
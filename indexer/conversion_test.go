@@ -0,0 +1,118 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/pokt-foundation/pocket-go/provider"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/pokt-foundation/utils-go/mock-client"
+	testMock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type conversionWarningsDriverMock struct {
+	driverMock
+}
+
+func (d *conversionWarningsDriverMock) WriteConversionWarnings(warnings []*types.ConversionWarning) error {
+	args := d.Called(warnings)
+
+	return args.Error(0)
+}
+
+func TestIndexer_SetConversionMode(t *testing.T) {
+	c := require.New(t)
+
+	indexer := NewIndexer(provider.NewProvider("https://dummy.com", []string{}), &driverMock{})
+
+	c.NoError(indexer.SetConversionMode(StrictConversionMode))
+	c.NoError(indexer.SetConversionMode(LenientConversionMode))
+	c.ErrorIs(indexer.SetConversionMode("silent"), ErrInvalidConversionMode)
+}
+
+func TestIndexer_IndexBlockTransactionsConversion(t *testing.T) {
+	c := require.New(t)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	reqProvider := provider.NewProvider("https://dummy.com", []string{})
+
+	driverMock := &conversionWarningsDriverMock{}
+
+	indexer := NewIndexer(reqProvider, driverMock)
+
+	mock.AddMultipleMockedResponses(http.MethodPost, fmt.Sprintf("%s%s", "https://dummy.com", provider.QueryBlockTXsRoute),
+		http.StatusOK, []string{
+			"../samples/query_block_txs.json",
+			"../samples/query_block_txs_empty.json",
+			"../samples/query_block_txs.json",
+			"../samples/query_block_txs_empty.json",
+			"../samples/query_block_txs.json",
+			"../samples/query_block_txs_empty.json",
+		})
+
+	expectedWarnings := []*types.ConversionWarning{
+		{
+			Height:  30363,
+			Entity:  "transaction",
+			Field:   "stdTx.fee.amount",
+			Value:   "string",
+			Hash:    "string",
+			Message: "invalid number",
+		},
+		{
+			Height:  30363,
+			Entity:  "transaction",
+			Field:   "stdTx.signature.pub_key",
+			Value:   "string",
+			Hash:    "string",
+			Message: "invalid public key",
+		},
+	}
+
+	driverMock.On("WriteTransactions", testMock.Anything).Return(nil)
+	driverMock.On("WriteConversionWarnings", expectedWarnings).Return(errors.New("forced failure")).Once()
+
+	err := indexer.IndexBlockTransactions(30363)
+	c.EqualError(err, "forced failure")
+
+	driverMock.On("WriteConversionWarnings", expectedWarnings).Return(nil).Once()
+
+	err = indexer.IndexBlockTransactions(30363)
+	c.NoError(err)
+
+	c.NoError(indexer.SetConversionMode(StrictConversionMode))
+
+	err = indexer.IndexBlockTransactions(30363)
+	c.ErrorIs(err, ErrInvalidNumber)
+	c.EqualError(err, `transaction string stdTx.fee.amount at height 30363: invalid number: "string"`)
+
+	var conversionErr *ConversionError
+	c.True(errors.As(err, &conversionErr))
+	c.Equal("stdTx.fee.amount", conversionErr.Field)
+
+	driverMock.AssertNumberOfCalls(t, "WriteTransactions", 2)
+}
+
+func TestConverter_ParseValues(t *testing.T) {
+	c := require.New(t)
+
+	conv := newConverter(21)
+	source := conversionSource{entity: nodeEntity, address: "00353abd21ef72725b295ba5a9a5eb6082548e21"}
+
+	c.Equal(7, conv.parseInt(source, "num_txs", "7"))
+	c.Equal(0, conv.parseInt(source, "num_txs", ""))
+	c.Equal("212121", conv.parseBigInt(source, "tokens", "212121").String())
+	c.Equal("0", conv.parseBigInt(source, "tokens", "12ab").String())
+
+	c.Len(conv.errs, 2)
+	c.ErrorIs(conv.errs[0], ErrMissingField)
+	c.ErrorIs(conv.errs[1], ErrInvalidNumber)
+	c.Equal("00353abd21ef72725b295ba5a9a5eb6082548e21", conv.errs[1].Address)
+	c.Equal(21, conv.errs[1].Height)
+}
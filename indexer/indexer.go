@@ -2,10 +2,18 @@
 package indexer
 
 import (
+	"errors"
+	"reflect"
+
 	"github.com/pokt-foundation/pocket-go/provider"
 	"github.com/pokt-foundation/pocket-indexer-lib/types"
 )
 
+var (
+	// ErrNetworkMismatch error when driver is set to a different network than the indexer
+	ErrNetworkMismatch = errors.New("driver network does not match indexer network")
+)
+
 // Provider interface of needed provider functions
 type Provider interface {
 	GetBlock(blockNumber int) (*provider.GetBlockOutput, error)
@@ -16,6 +24,8 @@ type Provider interface {
 }
 
 // Driver interface for driver methods needed to index
+// reads must see the indexer's own writes, drivers with a Primary method returning a Driver
+// are switched to their primary by the indexer constructors
 type Driver interface {
 	WriteBlock(block *types.Block) error
 	WriteTransactions(txs []*types.Transaction) error
@@ -32,16 +42,68 @@ type Driver interface {
 	WriteBlockCalculatedFields(block *types.Block) error
 }
 
+// networkDriver is implemented by drivers that are bound to a network
+type networkDriver interface {
+	Network() types.Network
+}
+
 // Indexer struct handler for Indexer functions
 type Indexer struct {
-	provider Provider
-	driver   Driver
+	provider       Provider
+	driver         Driver
+	network        types.Network
+	conversionMode ConversionMode
+	watcher        *watcher
+	plugins        []Plugin
 }
 
 // NewIndexer returns Indexer instance with given input
 func NewIndexer(provider Provider, writer Driver) *Indexer {
 	return &Indexer{
 		provider: provider,
-		driver:   writer,
+		driver:   getPrimaryDriver(writer),
+		watcher:  newWatcher(),
 	}
 }
+
+// NewIndexerWithNetwork returns Indexer instance for the given network
+// returns ErrNetworkMismatch if the driver is bound to another network
+func NewIndexerWithNetwork(provider Provider, writer Driver, network types.Network) (*Indexer, error) {
+	netDriver, ok := writer.(networkDriver)
+	if ok && netDriver.Network() != "" && netDriver.Network() != network {
+		return nil, ErrNetworkMismatch
+	}
+
+	return &Indexer{
+		provider: provider,
+		driver:   getPrimaryDriver(writer),
+		network:  network,
+		watcher:  newWatcher(),
+	}, nil
+}
+
+// getPrimaryDriver returns the driver returned by the Primary method of drivers routing reads to a read replica
+// so the indexer reads its own writes, drivers without it are returned as they are
+// the method is looked up by reflection as drivers return their own type
+func getPrimaryDriver(driver Driver) Driver {
+	method := reflect.ValueOf(driver).MethodByName("Primary")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return driver
+	}
+
+	primary, ok := method.Call(nil)[0].Interface().(Driver)
+	if !ok {
+		return driver
+	}
+
+	if value := reflect.ValueOf(primary); value.Kind() == reflect.Ptr && value.IsNil() {
+		return driver
+	}
+
+	return primary
+}
+
+// Network returns the network the indexer is set to, empty if none was set
+func (i *Indexer) Network() types.Network {
+	return i.network
+}
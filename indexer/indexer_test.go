@@ -0,0 +1,66 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/pokt-foundation/pocket-go/provider"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+type networkDriverMock struct {
+	driverMock
+	network types.Network
+}
+
+func (d *networkDriverMock) Network() types.Network {
+	return d.network
+}
+
+func TestNewIndexerWithNetwork(t *testing.T) {
+	c := require.New(t)
+
+	reqProvider := provider.NewProvider("https://dummy.com", []string{})
+
+	indexer, err := NewIndexerWithNetwork(reqProvider, &driverMock{}, types.MainnetNetwork)
+	c.NoError(err)
+	c.Equal(types.MainnetNetwork, indexer.Network())
+
+	indexer, err = NewIndexerWithNetwork(reqProvider, &networkDriverMock{network: types.TestnetNetwork}, types.TestnetNetwork)
+	c.NoError(err)
+	c.Equal(types.TestnetNetwork, indexer.Network())
+
+	indexer, err = NewIndexerWithNetwork(reqProvider, &networkDriverMock{network: types.TestnetNetwork}, types.MainnetNetwork)
+	c.Equal(ErrNetworkMismatch, err)
+	c.Empty(indexer)
+
+	c.Empty(NewIndexer(reqProvider, &driverMock{}).Network())
+}
+
+type replicaDriverMock struct {
+	driverMock
+	primary *driverMock
+}
+
+func (d *replicaDriverMock) Primary() *driverMock {
+	return d.primary
+}
+
+func TestNewIndexerUsesPrimaryDriver(t *testing.T) {
+	c := require.New(t)
+
+	reqProvider := provider.NewProvider("https://dummy.com", []string{})
+	primary := &driverMock{}
+
+	c.Same(primary, NewIndexer(reqProvider, &replicaDriverMock{primary: primary}).driver)
+
+	indexer, err := NewIndexerWithNetwork(reqProvider, &replicaDriverMock{primary: primary}, types.MainnetNetwork)
+	c.NoError(err)
+	c.Same(primary, indexer.driver)
+
+	driver := &replicaDriverMock{}
+	c.Same(driver, NewIndexer(reqProvider, driver).driver)
+
+	networkDriver := &networkDriverMock{}
+	c.Same(networkDriver, NewIndexer(reqProvider, networkDriver).driver)
+}
@@ -2,7 +2,6 @@ package indexer
 
 import (
 	"errors"
-	"math/big"
 
 	"github.com/pokt-foundation/pocket-go/provider"
 	"github.com/pokt-foundation/pocket-indexer-lib/types"
@@ -13,29 +12,63 @@ var (
 	ErrNoNodesToIndex = errors.New("no nodes to index")
 )
 
-func convertProviderNodeToNode(height int, provNode *provider.Node) *types.Node {
-	tokens := new(big.Int)
-	tokens, _ = tokens.SetString(provNode.Tokens, 10)
+func convertProviderNodeToNode(conv *converter, provNode *provider.Node) *types.Node {
+	source := conversionSource{entity: nodeEntity, address: provNode.Address}
 
 	return &types.Node{
-		Address:    provNode.Address,
-		Height:     height,
-		Jailed:     provNode.Jailed,
-		PublicKey:  provNode.PublicKey,
-		ServiceURL: provNode.ServiceURL,
-		Tokens:     tokens,
+		Address:       provNode.Address,
+		Height:        conv.height,
+		Jailed:        provNode.Jailed,
+		PublicKey:     provNode.PublicKey,
+		ServiceURL:    provNode.ServiceURL,
+		Tokens:        conv.parseBigInt(source, "tokens", provNode.Tokens),
+		Chains:        provNode.Chains,
+		OutputAddress: provNode.OutputAddress,
+		UnstakingTime: provNode.UnstakingTime,
 	}
 }
 
 // IndexBlockNodes converts nodes details to known structures and saves them
 // returns all addresses indexed
 func (i *Indexer) IndexBlockNodes(blockHeight int) ([]string, error) {
+	conv := newConverter(blockHeight)
+
+	nodes, err := i.getNodes(conv)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nodes) == 0 {
+		return nil, ErrNoNodesToIndex
+	}
+
+	var addresses []string
+
+	for _, node := range nodes {
+		addresses = append(addresses, node.Address)
+	}
+
+	err = i.checkConversion(conv)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.driver.WriteNodes(nodes)
+	if err != nil {
+		return addresses, err
+	}
+
+	return addresses, i.recordConversionWarnings(conv)
+}
+
+// getNodes returns the nodes of the converter height
+func (i *Indexer) getNodes(conv *converter) ([]*types.Node, error) {
 	totalPages := 1
-	var providerNodes []*provider.Node
+	var nodes []*types.Node
 
 	for page := 1; page <= totalPages; page++ {
 		nodesOutput, err := i.provider.GetNodes(&provider.GetNodesOptions{
-			Height:  blockHeight,
+			Height:  conv.height,
 			Page:    page,
 			PerPage: 10000,
 		})
@@ -47,20 +80,10 @@ func (i *Indexer) IndexBlockNodes(blockHeight int) ([]string, error) {
 			totalPages = nodesOutput.TotalPages
 		}
 
-		providerNodes = append(providerNodes, nodesOutput.Result...)
-	}
-
-	if len(providerNodes) == 0 {
-		return nil, ErrNoNodesToIndex
-	}
-
-	var nodes []*types.Node
-	var addresses []string
-
-	for _, node := range providerNodes {
-		nodes = append(nodes, convertProviderNodeToNode(blockHeight, node))
-		addresses = append(addresses, node.Address)
+		for _, node := range nodesOutput.Result {
+			nodes = append(nodes, convertProviderNodeToNode(conv, node))
+		}
 	}
 
-	return addresses, i.driver.WriteNodes(nodes)
+	return nodes, nil
 }
@@ -0,0 +1,233 @@
+package indexer
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+var (
+	// ErrHeightWriteNotSupported error when the driver can not write a height in a single transaction
+	ErrHeightWriteNotSupported = errors.New("driver does not support height writes")
+)
+
+// Plugin interface of the hooks run by IndexHeight
+type Plugin interface {
+	// BeforeWrite runs before the height is written, it can transform or annotate the height data
+	BeforeWrite(height int, data *types.HeightData) error
+	// Write runs in the height transaction after the height data is written,
+	// plugins write their own tables with tx and an error rolls back the whole height
+	Write(height int, data *types.HeightData, tx *sql.Tx) error
+	// AfterCommit runs once the height transaction is committed
+	AfterCommit(height int) error
+}
+
+// heightDriver is implemented by drivers that write all the values of a height in a single transaction
+// hook runs in the transaction after the height values are written
+type heightDriver interface {
+	WriteHeight(data *types.HeightData, hook func(tx *sql.Tx) error) error
+}
+
+// AddPlugin registers a plugin run by IndexHeight, plugins run in registration order
+func (i *Indexer) AddPlugin(plugin Plugin) {
+	i.plugins = append(i.plugins, plugin)
+}
+
+// IndexHeight indexes the block, transactions, accounts, nodes and apps of the height in a single transaction
+// once committed it indexes the calculated fields as IndexBlockCalculatedFields, took is 0 when the previous height
+// is not indexed, so the height is notified when all its values are written
+// plugins BeforeWrite hooks run before the write, their Write hooks in the transaction
+// and their AfterCommit hooks after the commit, a hook error stops the next hooks of the same kind
+// the calculated fields, AfterCommit hooks and watch rules run even if one of them fails as the height is already
+// committed, their errors are joined
+// on strict conversion mode malformed fields return a ConversionError before any hook runs
+func (i *Indexer) IndexHeight(blockHeight int) error {
+	hDriver, ok := i.driver.(heightDriver)
+	if !ok {
+		return ErrHeightWriteNotSupported
+	}
+
+	conv := newConverter(blockHeight)
+
+	data, err := i.getHeightData(conv)
+	if err != nil {
+		return err
+	}
+
+	err = i.checkConversion(conv)
+	if err != nil {
+		return err
+	}
+
+	err = i.runPluginsBeforeWrite(blockHeight, data)
+	if err != nil {
+		return err
+	}
+
+	err = i.createHeightPartitions(blockHeight)
+	if err != nil {
+		return err
+	}
+
+	err = hDriver.WriteHeight(data, func(tx *sql.Tx) error {
+		return i.runPluginsWrite(blockHeight, data, tx)
+	})
+	if err != nil {
+		return err
+	}
+
+	return joinErrors(
+		i.indexCommittedHeight(conv),
+		i.runPluginsAfterCommit(blockHeight),
+		i.EvaluateWatchRules(blockHeight),
+	)
+}
+
+// indexCommittedHeight records the conversion warnings and indexes the calculated fields of a committed height
+func (i *Indexer) indexCommittedHeight(conv *converter) error {
+	err := i.recordConversionWarnings(conv)
+	if err != nil {
+		return err
+	}
+
+	return i.indexCalculatedFields(conv.height, i.getIndexedDuration)
+}
+
+// getIndexedDuration returns the took of the height, 0 when the previous height is not indexed
+func (i *Indexer) getIndexedDuration(blockHeight int) (time.Duration, error) {
+	took, err := i.getDuration(blockHeight)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+
+	return took, err
+}
+
+// heightErrors error holding the errors of the steps run after a height is committed
+type heightErrors []error
+
+func (e heightErrors) Error() string {
+	messages := make([]string, 0, len(e))
+
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Is reports whether any of the errors matches target
+func (e heightErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first of the errors that matches target
+func (e heightErrors) As(target any) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// joinErrors returns the non nil errors, nil if there are none and the error itself if there is one
+func joinErrors(errs ...error) error {
+	var joined heightErrors
+
+	for _, err := range errs {
+		if err != nil {
+			joined = append(joined, err)
+		}
+	}
+
+	switch len(joined) {
+	case 0:
+		return nil
+	case 1:
+		return joined[0]
+	default:
+		return joined
+	}
+}
+
+func (i *Indexer) runPluginsBeforeWrite(blockHeight int, data *types.HeightData) error {
+	for _, plugin := range i.plugins {
+		err := plugin.BeforeWrite(blockHeight, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *Indexer) runPluginsWrite(blockHeight int, data *types.HeightData, tx *sql.Tx) error {
+	for _, plugin := range i.plugins {
+		err := plugin.Write(blockHeight, data, tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *Indexer) runPluginsAfterCommit(blockHeight int) error {
+	for _, plugin := range i.plugins {
+		err := plugin.AfterCommit(blockHeight)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getHeightData returns all the values of the converter height
+func (i *Indexer) getHeightData(conv *converter) (*types.HeightData, error) {
+	block, err := i.getBlock(conv)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := i.getBlockTransactions(conv)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := i.getAccounts(conv)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := i.getNodes(conv)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := i.getApps(conv)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.HeightData{
+		Height:       conv.height,
+		Block:        block,
+		Transactions: transactions,
+		Accounts:     accounts,
+		Nodes:        nodes,
+		Apps:         apps,
+		Annotations:  make(map[string]any),
+	}, nil
+}
@@ -0,0 +1,220 @@
+package indexer
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/pokt-foundation/pocket-go/provider"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/pokt-foundation/utils-go/mock-client"
+	testMock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type heightDriverMock struct {
+	driverMock
+}
+
+func (d *heightDriverMock) WriteHeight(data *types.HeightData, hook func(tx *sql.Tx) error) error {
+	args := d.Called(data)
+
+	err := args.Error(0)
+	if err != nil {
+		return err
+	}
+
+	return hook(nil)
+}
+
+type pluginMock struct {
+	testMock.Mock
+}
+
+func (p *pluginMock) BeforeWrite(height int, data *types.HeightData) error {
+	args := p.Called(height, data)
+
+	return args.Error(0)
+}
+
+func (p *pluginMock) Write(height int, data *types.HeightData, tx *sql.Tx) error {
+	args := p.Called(height, data, tx)
+
+	return args.Error(0)
+}
+
+func (p *pluginMock) AfterCommit(height int) error {
+	args := p.Called(height)
+
+	return args.Error(0)
+}
+
+func mockHeightResponses() {
+	routeFiles := map[provider.V1RPCRoute]string{
+		provider.QueryBlockRoute:    "../samples/query_block.json",
+		provider.QueryAccountsRoute: "../samples/query_accounts.json",
+		provider.QueryNodesRoute:    "../samples/query_nodes.json",
+		provider.QueryAppsRoute:     "../samples/query_apps.json",
+	}
+
+	for route, file := range routeFiles {
+		mock.AddMockedResponseFromFile(http.MethodPost, fmt.Sprintf("%s%s", "https://dummy.com", route), http.StatusOK, file)
+	}
+
+	mock.AddMultipleMockedResponses(http.MethodPost, fmt.Sprintf("%s%s", "https://dummy.com", provider.QueryBlockTXsRoute),
+		http.StatusOK, []string{
+			"../samples/query_block_txs.json",
+			"../samples/query_block_txs_empty.json",
+		})
+}
+
+func mockHeightCalculatedFields(driverMock *testMock.Mock) {
+	driverMock.On("GetAccountsQuantity", testMock.Anything).Return(int64(21), nil).Once()
+	driverMock.On("GetAppsQuantity", testMock.Anything).Return(int64(21), nil).Once()
+	driverMock.On("GetNodesQuantity", testMock.Anything).Return(int64(21), nil).Once()
+	driverMock.On("ReadBlockByHeight", 30362).Return((*types.Block)(nil), sql.ErrNoRows).Once()
+	driverMock.On("WriteBlockCalculatedFields", &types.Block{
+		Height:           30363,
+		AccountsQuantity: 21,
+		AppsQuantity:     21,
+		NodesQuantity:    21,
+	}).Return(nil).Once()
+}
+
+func TestIndexer_IndexHeight(t *testing.T) {
+	c := require.New(t)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	reqProvider := provider.NewProvider("https://dummy.com", []string{})
+
+	indexer := NewIndexer(reqProvider, &driverMock{})
+
+	err := indexer.IndexHeight(30363)
+	c.Equal(ErrHeightWriteNotSupported, err)
+
+	driverMock := &heightDriverMock{}
+	indexer = NewIndexer(reqProvider, driverMock)
+
+	plugin := &pluginMock{}
+	indexer.AddPlugin(plugin)
+
+	isHeightData := testMock.MatchedBy(func(data *types.HeightData) bool {
+		return data.Height == 30363 && data.Block != nil && len(data.Transactions) == 1 &&
+			len(data.Accounts) > 0 && len(data.Nodes) > 0 && len(data.Apps) > 0
+	})
+
+	mockHeightResponses()
+
+	plugin.On("BeforeWrite", 30363, isHeightData).Return(errors.New("forced failure")).Once()
+
+	err = indexer.IndexHeight(30363)
+	c.EqualError(err, "forced failure")
+
+	mockHeightResponses()
+
+	plugin.On("BeforeWrite", 30363, isHeightData).Run(func(args testMock.Arguments) {
+		args.Get(1).(*types.HeightData).Annotations["enriched"] = true
+	}).Return(nil)
+	driverMock.On("WriteHeight", isHeightData).Return(nil)
+	plugin.On("Write", 30363, isHeightData, (*sql.Tx)(nil)).Return(errors.New("rolled back")).Once()
+
+	err = indexer.IndexHeight(30363)
+	c.EqualError(err, "rolled back")
+
+	mockHeightResponses()
+
+	plugin.On("Write", 30363, testMock.MatchedBy(func(data *types.HeightData) bool {
+		enriched, _ := data.Annotations["enriched"].(bool)
+		return enriched
+	}), (*sql.Tx)(nil)).Return(nil).Once()
+	plugin.On("AfterCommit", 30363).Return(nil).Once()
+	mockHeightCalculatedFields(&driverMock.Mock)
+
+	err = indexer.IndexHeight(30363)
+	c.NoError(err)
+
+	plugin.AssertExpectations(t)
+	driverMock.AssertExpectations(t)
+	driverMock.AssertNumberOfCalls(t, "WriteHeight", 2)
+}
+
+type heightWatchDriverMock struct {
+	watchDriverMock
+}
+
+func (d *heightWatchDriverMock) WriteHeight(data *types.HeightData, hook func(tx *sql.Tx) error) error {
+	args := d.Called(data)
+
+	return args.Error(0)
+}
+
+func TestIndexer_IndexHeightEvaluatesWatchRules(t *testing.T) {
+	c := require.New(t)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	driverMock := &heightWatchDriverMock{}
+	indexer := NewIndexer(provider.NewProvider("https://dummy.com", []string{}), driverMock)
+
+	alerts := make(chan *WatchAlert, 1)
+	indexer.AddWatchSink(NewChannelWatchSink(alerts))
+
+	c.NoError(indexer.AddWatchRule(&WatchRule{ID: "activity", Kind: AddressActivityWatchRule, Address: watchedAddress}))
+
+	mockHeightResponses()
+
+	driverMock.On("WriteHeight", testMock.Anything).Return(nil).Once()
+	driverMock.On("ReadTransactionsByHeight", 30363, testMock.Anything).Return([]*types.Transaction{
+		{Hash: "ABCD", FromAddress: watchedAddress, ToAddress: counterpartyAddress, MessageType: "pos/Send"},
+	}, nil).Once()
+	mockHeightCalculatedFields(&driverMock.Mock)
+
+	c.NoError(indexer.IndexHeight(30363))
+	c.Len(alerts, 1)
+	c.Equal("ABCD", (<-alerts).TransactionHash)
+
+	driverMock.AssertExpectations(t)
+}
+
+func TestIndexer_IndexHeightEvaluatesWatchRulesOnCommittedHeightErrors(t *testing.T) {
+	c := require.New(t)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	driverMock := &heightWatchDriverMock{}
+	indexer := NewIndexer(provider.NewProvider("https://dummy.com", []string{}), driverMock)
+
+	alerts := make(chan *WatchAlert, 1)
+	indexer.AddWatchSink(NewChannelWatchSink(alerts))
+
+	c.NoError(indexer.AddWatchRule(&WatchRule{ID: "activity", Kind: AddressActivityWatchRule, Address: watchedAddress}))
+
+	plugin := &pluginMock{}
+	indexer.AddPlugin(plugin)
+
+	mockHeightResponses()
+
+	plugin.On("BeforeWrite", 30363, testMock.Anything).Return(nil).Once()
+	plugin.On("AfterCommit", 30363).Return(errors.New("error on after commit")).Once()
+	driverMock.On("WriteHeight", testMock.Anything).Return(nil).Once()
+	driverMock.On("GetAccountsQuantity", testMock.Anything).Return(int64(0), sql.ErrConnDone).Once()
+	driverMock.On("ReadTransactionsByHeight", 30363, testMock.Anything).Return([]*types.Transaction{
+		{Hash: "ABCD", FromAddress: watchedAddress, ToAddress: counterpartyAddress, MessageType: "pos/Send"},
+	}, nil).Once()
+
+	err := indexer.IndexHeight(30363)
+	c.EqualError(err, sql.ErrConnDone.Error()+"; error on after commit")
+	c.ErrorIs(err, sql.ErrConnDone)
+	c.Len(alerts, 1)
+	c.Equal("ABCD", (<-alerts).TransactionHash)
+
+	plugin.AssertExpectations(t)
+	driverMock.AssertExpectations(t)
+}
@@ -3,9 +3,9 @@ package indexer
 import (
 	"errors"
 	"math/big"
-	"strconv"
 
 	"github.com/pokt-foundation/pocket-go/provider"
+	"github.com/pokt-foundation/pocket-go/utils"
 	"github.com/pokt-foundation/pocket-indexer-lib/types"
 )
 
@@ -14,95 +14,196 @@ var (
 	ErrNoTransactionsToIndex = errors.New("no transactions to index")
 )
 
-func convertProviderTransactionToTransaction(providerTransaction *provider.Transaction) *types.Transaction {
-	var fromAddress, toAddress string
-	var blockChains []string
-
-	amount := new(big.Int)
+// convertProviderTransactionToTransaction converts the provider transaction
+// missing stdTx, msg and signature are malformed fields and leave their values empty
+func convertProviderTransactionToTransaction(conv *converter, providerTransaction *provider.Transaction) *types.Transaction {
+	source := conversionSource{entity: transactionEntity, hash: providerTransaction.Hash}
+
+	transaction := &types.Transaction{
+		Hash:          providerTransaction.Hash,
+		Height:        providerTransaction.Height,
+		Index:         providerTransaction.Index,
+		StdTx:         providerTransaction.StdTx,
+		TxResult:      providerTransaction.TxResult,
+		Tx:            providerTransaction.Tx,
+		Fee:           new(big.Int),
+		Amount:        new(big.Int),
+		ProofRootHash: getProofRootHash(providerTransaction.Proof),
+	}
 
 	stdTx := providerTransaction.StdTx
-	msgValues := stdTx.Msg.Value
-	feeStruct := stdTx.Fee[0]
+	if stdTx == nil {
+		conv.addError(source, "stdTx", "", ErrMissingField)
+		return transaction
+	}
+
+	transaction.Entropy = int(stdTx.Entropy)
+	transaction.Memo = stdTx.Memo
+	transaction.Fees = convertProviderFeesToCoins(conv, source, stdTx.Fee)
+
+	if len(transaction.Fees) > 0 {
+		transaction.Fee = transaction.Fees[0].Amount
+		transaction.FeeDenomination = transaction.Fees[0].Denomination
+	}
+
+	setMsgFields(conv, source, transaction, stdTx.Msg)
+	setSignatureFields(conv, source, transaction, stdTx.Signature)
 
-	rawFromAddress, ok := msgValues["from_address"].(string)
+	return transaction
+}
+
+// setMsgFields sets the transaction fields read from the message
+func setMsgFields(conv *converter, source conversionSource, transaction *types.Transaction, msg *provider.TxMsg) {
+	if msg == nil {
+		conv.addError(source, "stdTx.msg", "", ErrMissingField)
+		return
+	}
+
+	rawFromAddress, ok := msg.Value["from_address"].(string)
 	if ok {
-		fromAddress = rawFromAddress
+		transaction.FromAddress = rawFromAddress
 	}
 
-	rawToAddress, ok := msgValues["to_address"].(string)
+	rawToAddress, ok := msg.Value["to_address"].(string)
 	if ok {
-		toAddress = rawToAddress
+		transaction.ToAddress = rawToAddress
 	}
 
-	rawAmount, ok := msgValues["amount"].(string)
+	rawAmount, ok := msg.Value["amount"].(string)
 	if ok {
-		amount, _ = amount.SetString(rawAmount, 10)
+		transaction.Amount = conv.parseBigInt(source, "stdTx.msg.value.amount", rawAmount)
+	}
+
+	transaction.MessageType = msg.Type
+	transaction.Blockchains = getMsgBlockchains(msg.Value)
+}
+
+// setSignatureFields sets the transaction fields read from the signature
+func setSignatureFields(conv *converter, source conversionSource, transaction *types.Transaction, signature *provider.TxSignature) {
+	if signature == nil {
+		conv.addError(source, "stdTx.signature", "", ErrMissingField)
+		return
 	}
 
+	transaction.AppPubKey = signature.PubKey
+	transaction.SignerAddress = getSignerAddress(conv, source, signature.PubKey)
+	transaction.Signature = signature.Signature
+}
+
+// getSignerAddress returns the address of the given signer public key
+// empty public keys return an empty address
+func getSignerAddress(conv *converter, source conversionSource, publicKey string) string {
+	if publicKey == "" {
+		return ""
+	}
+
+	if !utils.ValidatePublicKey(publicKey) {
+		conv.addError(source, "stdTx.signature.pub_key", publicKey, ErrInvalidPublicKey)
+		return ""
+	}
+
+	// validated public keys are always decodable
+	address, _ := utils.GetAddressFromPublickey(publicKey)
+
+	return address
+}
+
+func getProofRootHash(proof *provider.TransactionProof) string {
+	if proof == nil {
+		return ""
+	}
+
+	return proof.RootHash
+}
+
+func getMsgBlockchains(msgValues map[string]any) []string {
+	var blockChains []string
+
 	rawBlockChains, ok := msgValues["chains"].([]any)
-	if ok {
-		for _, rawBlockChain := range rawBlockChains {
-			blockChain, ok := rawBlockChain.(string)
-			if ok {
-				blockChains = append(blockChains, blockChain)
-			}
+	if !ok {
+		return nil
+	}
+
+	for _, rawBlockChain := range rawBlockChains {
+		blockChain, ok := rawBlockChain.(string)
+		if ok {
+			blockChains = append(blockChains, blockChain)
 		}
 	}
 
-	fee, _ := strconv.Atoi(feeStruct.Amount)
-
-	return &types.Transaction{
-		Hash:            providerTransaction.Hash,
-		FromAddress:     fromAddress,
-		ToAddress:       toAddress,
-		AppPubKey:       stdTx.Signature.PubKey,
-		Blockchains:     blockChains,
-		MessageType:     stdTx.Msg.Type,
-		Height:          providerTransaction.Height,
-		Index:           providerTransaction.Index,
-		StdTx:           stdTx,
-		TxResult:        providerTransaction.TxResult,
-		Tx:              providerTransaction.Tx,
-		Entropy:         int(stdTx.Entropy),
-		Fee:             fee,
-		FeeDenomination: feeStruct.Denom,
-		Amount:          amount,
+	return blockChains
+}
+
+func convertProviderFeesToCoins(conv *converter, source conversionSource, providerFees []*provider.Fee) []*types.Coin {
+	if len(providerFees) == 0 {
+		conv.addError(source, "stdTx.fee", "", ErrMissingField)
+		return nil
+	}
+
+	var coins []*types.Coin
+
+	for _, providerFee := range providerFees {
+		coins = append(coins, &types.Coin{
+			Denomination: providerFee.Denom,
+			Amount:       conv.parseBigInt(source, "stdTx.fee.amount", providerFee.Amount),
+		})
 	}
+
+	return coins
 }
 
 // IndexBlockTransactions converts block transactions to a known structure and saves them
+// on strict conversion mode malformed transaction fields return a ConversionError
 func (i *Indexer) IndexBlockTransactions(blockHeight int) error {
+	conv := newConverter(blockHeight)
+
+	transactions, err := i.getBlockTransactions(conv)
+	if err != nil {
+		return err
+	}
+
+	if len(transactions) == 0 {
+		return ErrNoTransactionsToIndex
+	}
+
+	err = i.checkConversion(conv)
+	if err != nil {
+		return err
+	}
+
+	err = i.driver.WriteTransactions(transactions)
+	if err != nil {
+		return err
+	}
+
+	return i.recordConversionWarnings(conv)
+}
+
+// getBlockTransactions returns the transactions of the converter height
+func (i *Indexer) getBlockTransactions(conv *converter) ([]*types.Transaction, error) {
 	currentPage := 1
-	var providerTxs []*provider.Transaction
+	var transactions []*types.Transaction
 
 	for {
 		blockTransactionsOutput, err := i.provider.GetBlockTransactions(&provider.GetBlockTransactionsOptions{
-			Height:  blockHeight,
+			Height:  conv.height,
 			Page:    currentPage,
 			PerPage: 10000,
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		if blockTransactionsOutput.PageCount == 0 {
 			break
 		}
 
-		providerTxs = append(providerTxs, blockTransactionsOutput.Txs...)
+		for _, tx := range blockTransactionsOutput.Txs {
+			transactions = append(transactions, convertProviderTransactionToTransaction(conv, tx))
+		}
 
 		currentPage++
 	}
 
-	if len(providerTxs) == 0 {
-		return ErrNoTransactionsToIndex
-	}
-
-	var transactions []*types.Transaction
-
-	for _, tx := range providerTxs {
-		transactions = append(transactions, convertProviderTransactionToTransaction(tx))
-	}
-
-	return i.driver.WriteTransactions(transactions)
+	return transactions, nil
 }
@@ -55,3 +55,65 @@ func TestIndexer_IndexBlockTransactions(t *testing.T) {
 	err = indexer.IndexBlockTransactions(30363)
 	c.NoError(err)
 }
+
+func TestGetSignerAddress(t *testing.T) {
+	c := require.New(t)
+
+	conv := newConverter(21)
+	source := conversionSource{entity: transactionEntity, hash: "ABCD"}
+
+	c.Equal("9518adc26784af2606ed75e167850743d530a423",
+		getSignerAddress(conv, source, "a6258b46ecad0628b72099f91e87eef1b040a8747ed2d476f56ad359372bf0a9"))
+	c.Empty(getSignerAddress(conv, source, ""))
+	c.Empty(conv.errs)
+
+	c.Empty(getSignerAddress(conv, source, "string"))
+	c.Len(conv.errs, 1)
+	c.ErrorIs(conv.errs[0], ErrInvalidPublicKey)
+}
+
+func TestConvertProviderTransactionToTransactionMissingFields(t *testing.T) {
+	c := require.New(t)
+
+	indexer := NewIndexer(provider.NewProvider("https://dummy.com", []string{}), &driverMock{})
+	c.NoError(indexer.SetConversionMode(StrictConversionMode))
+
+	fee := []*provider.Fee{{Amount: "10000", Denom: "upokt"}}
+
+	tests := []struct {
+		name          string
+		stdTx         *provider.StdTx
+		expectedField string
+	}{
+		{
+			name:          "missing stdTx",
+			expectedField: "stdTx",
+		},
+		{
+			name:          "missing msg",
+			stdTx:         &provider.StdTx{Fee: fee, Signature: &provider.TxSignature{}},
+			expectedField: "stdTx.msg",
+		},
+		{
+			name:          "missing signature",
+			stdTx:         &provider.StdTx{Fee: fee, Msg: &provider.TxMsg{Type: "pos/Send"}},
+			expectedField: "stdTx.signature",
+		},
+	}
+
+	for _, tt := range tests {
+		conv := newConverter(21)
+
+		transaction := convertProviderTransactionToTransaction(conv, &provider.Transaction{Hash: "ABCD", StdTx: tt.stdTx})
+		c.Equal("ABCD", transaction.Hash, tt.name)
+		c.Zero(transaction.Amount.Int64(), tt.name)
+		c.Len(conv.errs, 1, tt.name)
+
+		err := indexer.checkConversion(conv)
+		c.ErrorIs(err, ErrMissingField, tt.name)
+
+		var conversionErr *ConversionError
+		c.True(errors.As(err, &conversionErr), tt.name)
+		c.Equal(tt.expectedField, conversionErr.Field, tt.name)
+	}
+}
@@ -0,0 +1,461 @@
+package indexer
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/pokt-foundation/pocket-go/utils"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const watchTransactionsPerPage = 1000
+
+var (
+	// ErrWatchNotSupported error when the driver can not read the values watch rules are evaluated on
+	ErrWatchNotSupported = errors.New("driver does not support watch rules")
+	// ErrInvalidWatchRule error when a watch rule is missing or has invalid values
+	ErrInvalidWatchRule = errors.New("invalid watch rule")
+	// ErrDuplicatedWatchRule error when a watch rule with the same ID was already added
+	ErrDuplicatedWatchRule = errors.New("watch rule already added")
+)
+
+// WatchRuleKind enum of the events a watch rule matches
+type WatchRuleKind string
+
+const (
+	// AddressActivityWatchRule matches the transactions sent, received or signed by the address
+	AddressActivityWatchRule WatchRuleKind = "address_activity"
+	// BalanceThresholdWatchRule matches the address balance rising above or falling below the threshold
+	BalanceThresholdWatchRule WatchRuleKind = "balance_threshold"
+	// NodeJailStatusWatchRule matches the node of the address getting jailed or unjailed
+	NodeJailStatusWatchRule WatchRuleKind = "node_jail_status"
+	// StakeChangedWatchRule matches changes of the tokens staked by the node or app of the address
+	StakeChangedWatchRule WatchRuleKind = "stake_changed"
+)
+
+// WatchRule struct handler of a watched event
+// Threshold and Denomination are only used by BalanceThresholdWatchRule, Denomination defaults to upokt
+type WatchRule struct {
+	ID           string
+	Kind         WatchRuleKind
+	Address      string
+	Threshold    *big.Int
+	Denomination string
+}
+
+// WatchAlert struct handler of a watch rule match delivered to the sinks
+type WatchAlert struct {
+	RuleID          string        `json:"rule_id"`
+	Kind            WatchRuleKind `json:"kind"`
+	Address         string        `json:"address"`
+	Height          int           `json:"height"`
+	TransactionHash string        `json:"transaction_hash,omitempty"`
+	Message         string        `json:"message"`
+}
+
+// WatchSink interface of the destinations of the watch alerts
+type WatchSink interface {
+	Send(alert *WatchAlert) error
+}
+
+// watchDriver is implemented by drivers that can read the values watch rules are evaluated on
+// reads of values not saved at the height return sql.ErrNoRows
+type watchDriver interface {
+	ReadTransactionsByHeight(height int, options *types.ReadTransactionsByHeightOptions) ([]*types.Transaction, error)
+	ReadAccountByAddress(address string, options *types.ReadAccountByAddressOptions) (*types.Account, error)
+	ReadNodeByAddress(address string, options *types.ReadNodeByAddressOptions) (*types.Node, error)
+	ReadAppByAddress(address string, options *types.ReadAppByAddressOptions) (*types.App, error)
+}
+
+// watchState struct handler of the values a rule saw on the last evaluated height
+type watchState struct {
+	balance   *big.Int
+	jailed    *bool
+	nodeStake *big.Int
+	appStake  *big.Int
+}
+
+// watcher struct handler of the registered watch rules and sinks
+type watcher struct {
+	mu     sync.Mutex
+	rules  []*WatchRule
+	states map[string]*watchState
+	sinks  []WatchSink
+}
+
+func newWatcher() *watcher {
+	return &watcher{
+		states: make(map[string]*watchState),
+	}
+}
+
+func validateWatchRule(rule *WatchRule) error {
+	if rule == nil || rule.ID == "" {
+		return fmt.Errorf("%w: missing ID", ErrInvalidWatchRule)
+	}
+
+	if !utils.ValidateAddress(rule.Address) {
+		return fmt.Errorf("%w: invalid address: %q", ErrInvalidWatchRule, rule.Address)
+	}
+
+	if _, ok := watchEvaluators[rule.Kind]; !ok {
+		return fmt.Errorf("%w: invalid kind: %q", ErrInvalidWatchRule, rule.Kind)
+	}
+
+	if rule.Kind == BalanceThresholdWatchRule && rule.Threshold == nil {
+		return fmt.Errorf("%w: missing threshold", ErrInvalidWatchRule)
+	}
+
+	return nil
+}
+
+// AddWatchRule registers a rule evaluated by EvaluateWatchRules
+// the rule address is lowercased as the stored addresses, so alerts hold the lowercase address
+// balance threshold rules without denomination are registered with upokt
+func (i *Indexer) AddWatchRule(rule *WatchRule) error {
+	err := validateWatchRule(rule)
+	if err != nil {
+		return err
+	}
+
+	ruleCopy := *rule
+	ruleCopy.Address = strings.ToLower(rule.Address)
+	rule = &ruleCopy
+
+	if rule.Kind == BalanceThresholdWatchRule && rule.Denomination == "" {
+		rule.Denomination = types.NativeDenomination
+	}
+
+	i.watcher.mu.Lock()
+	defer i.watcher.mu.Unlock()
+
+	for _, registeredRule := range i.watcher.rules {
+		if registeredRule.ID == rule.ID {
+			return fmt.Errorf("%w: %q", ErrDuplicatedWatchRule, rule.ID)
+		}
+	}
+
+	i.watcher.rules = append(i.watcher.rules, rule)
+
+	return nil
+}
+
+// RemoveWatchRule unregisters the rule with given ID, unknown IDs are ignored
+func (i *Indexer) RemoveWatchRule(id string) {
+	i.watcher.mu.Lock()
+	defer i.watcher.mu.Unlock()
+
+	for index, rule := range i.watcher.rules {
+		if rule.ID == id {
+			i.watcher.rules = append(i.watcher.rules[:index], i.watcher.rules[index+1:]...)
+			delete(i.watcher.states, id)
+
+			return
+		}
+	}
+}
+
+// AddWatchSink registers a sink receiving every watch alert
+func (i *Indexer) AddWatchSink(sink WatchSink) {
+	i.watcher.mu.Lock()
+	defer i.watcher.mu.Unlock()
+
+	i.watcher.sinks = append(i.watcher.sinks, sink)
+}
+
+// EvaluateWatchRules evaluates the watch rules on given height and sends the matches to the sinks
+// IndexHeight calls it after each height, heights indexed with the other Index functions need to call it
+// once the height is indexed, balance, jail and stake rules compare the height
+// with the previous height they were evaluated on, so their first evaluation does not match
+// every sink receives every alert even if a sink fails, the first sink error is returned
+func (i *Indexer) EvaluateWatchRules(blockHeight int) error {
+	alerts, sinks, err := i.watcher.evaluate(i.driver, blockHeight)
+	if err != nil {
+		return err
+	}
+
+	return sendWatchAlerts(alerts, sinks)
+}
+
+// evaluate returns the alerts of the rules on given height and the sinks to send them to
+// alerts are sent by the caller so slow sinks do not block the rules and sinks registration
+func (w *watcher) evaluate(driver Driver, blockHeight int) ([]*WatchAlert, []WatchSink, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.rules) == 0 {
+		return nil, nil, nil
+	}
+
+	reader, ok := driver.(watchDriver)
+	if !ok {
+		return nil, nil, ErrWatchNotSupported
+	}
+
+	evaluation := &watchEvaluation{driver: reader, height: blockHeight}
+
+	// states are only kept when every rule is evaluated so a failed evaluation can be retried
+	states := make(map[string]*watchState, len(w.rules))
+
+	var alerts []*WatchAlert
+
+	for _, rule := range w.rules {
+		state := &watchState{}
+		if previousState, ok := w.states[rule.ID]; ok {
+			*state = *previousState
+		}
+
+		ruleAlerts, err := watchEvaluators[rule.Kind](evaluation, rule, state)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		states[rule.ID] = state
+		alerts = append(alerts, ruleAlerts...)
+	}
+
+	w.states = states
+
+	return alerts, append([]WatchSink(nil), w.sinks...), nil
+}
+
+func sendWatchAlerts(alerts []*WatchAlert, sinks []WatchSink) error {
+	var firstErr error
+
+	for _, alert := range alerts {
+		for _, sink := range sinks {
+			err := sink.Send(alert)
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// watchEvaluation struct handler of the values read to evaluate the rules on a height
+type watchEvaluation struct {
+	driver       watchDriver
+	height       int
+	transactions []*types.Transaction
+	txsRead      bool
+}
+
+type watchEvaluator func(evaluation *watchEvaluation, rule *WatchRule, state *watchState) ([]*WatchAlert, error)
+
+var watchEvaluators = map[WatchRuleKind]watchEvaluator{
+	AddressActivityWatchRule:  evaluateAddressActivity,
+	BalanceThresholdWatchRule: evaluateBalanceThreshold,
+	NodeJailStatusWatchRule:   evaluateNodeJailStatus,
+	StakeChangedWatchRule:     evaluateStakeChanged,
+}
+
+func (e *watchEvaluation) newAlert(rule *WatchRule, message string) *WatchAlert {
+	return &WatchAlert{
+		RuleID:  rule.ID,
+		Kind:    rule.Kind,
+		Address: rule.Address,
+		Height:  e.height,
+		Message: message,
+	}
+}
+
+// getTransactions reads the transactions of the height once for all the rules
+func (e *watchEvaluation) getTransactions() ([]*types.Transaction, error) {
+	if e.txsRead {
+		return e.transactions, nil
+	}
+
+	for page := 1; ; page++ {
+		transactions, err := e.driver.ReadTransactionsByHeight(e.height, &types.ReadTransactionsByHeightOptions{
+			Page:    page,
+			PerPage: watchTransactionsPerPage,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		e.transactions = append(e.transactions, transactions...)
+
+		if len(transactions) < watchTransactionsPerPage {
+			break
+		}
+	}
+
+	e.txsRead = true
+
+	return e.transactions, nil
+}
+
+func evaluateAddressActivity(evaluation *watchEvaluation, rule *WatchRule, _ *watchState) ([]*WatchAlert, error) {
+	transactions, err := evaluation.getTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	var alerts []*WatchAlert
+
+	for _, transaction := range transactions {
+		var role string
+
+		switch rule.Address {
+		case transaction.FromAddress:
+			role = "sent"
+		case transaction.ToAddress:
+			role = "received"
+		case transaction.SignerAddress:
+			role = "signed"
+		default:
+			continue
+		}
+
+		alert := evaluation.newAlert(rule, fmt.Sprintf("address %s %s transaction %s", rule.Address, role, transaction.MessageType))
+		alert.TransactionHash = transaction.Hash
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, nil
+}
+
+func evaluateBalanceThreshold(evaluation *watchEvaluation, rule *WatchRule, state *watchState) ([]*WatchAlert, error) {
+	account, err := evaluation.driver.ReadAccountByAddress(rule.Address, &types.ReadAccountByAddressOptions{Height: evaluation.height})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	balance := getDenominationBalance(account, rule.Denomination)
+
+	previousBalance := state.balance
+	state.balance = balance
+
+	if previousBalance == nil {
+		return nil, nil
+	}
+
+	wasAbove := previousBalance.Cmp(rule.Threshold) >= 0
+	isAbove := balance.Cmp(rule.Threshold) >= 0
+
+	if wasAbove == isAbove {
+		return nil, nil
+	}
+
+	direction := "fell below"
+	if isAbove {
+		direction = "rose above"
+	}
+
+	return []*WatchAlert{evaluation.newAlert(rule,
+		fmt.Sprintf("balance %s threshold %s: %s", direction, rule.Threshold, balance))}, nil
+}
+
+// getDenominationBalance returns the account amount of the denomination, no account or coin is 0
+// accounts saved before balances only hold their first coin on Balance
+func getDenominationBalance(account *types.Account, denomination string) *big.Int {
+	balance := new(big.Int)
+
+	if account == nil {
+		return balance
+	}
+
+	if len(account.Balances) == 0 && account.BalanceDenomination == denomination && account.Balance != nil {
+		return account.Balance
+	}
+
+	for _, coin := range account.Balances {
+		if coin.Denomination == denomination && coin.Amount != nil {
+			balance.Add(balance, coin.Amount)
+		}
+	}
+
+	return balance
+}
+
+func evaluateNodeJailStatus(evaluation *watchEvaluation, rule *WatchRule, state *watchState) ([]*WatchAlert, error) {
+	node, err := evaluation.driver.ReadNodeByAddress(rule.Address, &types.ReadNodeByAddressOptions{Height: evaluation.height})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	wasJailed := state.jailed
+	state.jailed = &node.Jailed
+
+	if wasJailed == nil || *wasJailed == node.Jailed {
+		return nil, nil
+	}
+
+	status := "unjailed"
+	if node.Jailed {
+		status = "jailed"
+	}
+
+	return []*WatchAlert{evaluation.newAlert(rule, fmt.Sprintf("node %s", status))}, nil
+}
+
+func evaluateStakeChanged(evaluation *watchEvaluation, rule *WatchRule, state *watchState) ([]*WatchAlert, error) {
+	nodeStake, appStake, err := evaluation.readStakes(rule.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	previousNodeStake, previousAppStake := state.nodeStake, state.appStake
+	state.nodeStake, state.appStake = nodeStake, appStake
+
+	if previousNodeStake == nil {
+		return nil, nil
+	}
+
+	var alerts []*WatchAlert
+
+	if previousNodeStake.Cmp(nodeStake) != 0 {
+		alerts = append(alerts, evaluation.newAlert(rule,
+			fmt.Sprintf("node stake changed from %s to %s", previousNodeStake, nodeStake)))
+	}
+
+	if previousAppStake.Cmp(appStake) != 0 {
+		alerts = append(alerts, evaluation.newAlert(rule,
+			fmt.Sprintf("app stake changed from %s to %s", previousAppStake, appStake)))
+	}
+
+	return alerts, nil
+}
+
+// readStakes returns the tokens staked by the node and app of the address, not staked is 0
+func (e *watchEvaluation) readStakes(address string) (*big.Int, *big.Int, error) {
+	nodeStake, appStake := new(big.Int), new(big.Int)
+
+	node, err := e.driver.ReadNodeByAddress(address, &types.ReadNodeByAddressOptions{Height: e.height})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, err
+	}
+
+	if node != nil {
+		nodeStake = getStake(node.Tokens)
+	}
+
+	app, err := e.driver.ReadAppByAddress(address, &types.ReadAppByAddressOptions{Height: e.height})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, err
+	}
+
+	if app != nil {
+		appStake = getStake(app.StakedTokens)
+	}
+
+	return nodeStake, appStake, nil
+}
+
+func getStake(tokens *big.Int) *big.Int {
+	if tokens == nil {
+		return new(big.Int)
+	}
+
+	return tokens
+}
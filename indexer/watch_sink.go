@@ -0,0 +1,111 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+var (
+	// ErrWatchChannelFull error when the channel of a ChannelWatchSink can not receive more alerts
+	ErrWatchChannelFull = errors.New("watch channel is full")
+	// ErrWebhookStatus error when a webhook responds with a non success status
+	ErrWebhookStatus = errors.New("webhook responded with non success status")
+)
+
+// ChannelWatchSink sends the watch alerts to a channel
+// sends do not block, alerts are dropped with ErrWatchChannelFull when the channel is full
+type ChannelWatchSink struct {
+	alerts chan<- *WatchAlert
+}
+
+// NewChannelWatchSink returns ChannelWatchSink instance sending to given channel
+func NewChannelWatchSink(alerts chan<- *WatchAlert) *ChannelWatchSink {
+	return &ChannelWatchSink{
+		alerts: alerts,
+	}
+}
+
+// Send sends the alert to the channel
+func (s *ChannelWatchSink) Send(alert *WatchAlert) error {
+	select {
+	case s.alerts <- alert:
+		return nil
+	default:
+		return ErrWatchChannelFull
+	}
+}
+
+// WebhookWatchSink posts the watch alerts as JSON to a URL
+type WebhookWatchSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookWatchSink returns WebhookWatchSink instance posting to given URL
+// nil client defaults to a client with a 10 seconds timeout
+func NewWebhookWatchSink(url string, client *http.Client) *WebhookWatchSink {
+	if client == nil {
+		client = &http.Client{Timeout: defaultWebhookTimeout}
+	}
+
+	return &WebhookWatchSink{
+		url:    url,
+		client: client,
+	}
+}
+
+// Send posts the alert to the webhook URL
+func (s *WebhookWatchSink) Send(alert *WatchAlert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %d", ErrWebhookStatus, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// LogWatchSink writes the watch alerts to a logger
+type LogWatchSink struct {
+	logger *log.Logger
+}
+
+// NewLogWatchSink returns LogWatchSink instance writing to given logger
+// nil logger defaults to the standard logger
+func NewLogWatchSink(logger *log.Logger) *LogWatchSink {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return &LogWatchSink{
+		logger: logger,
+	}
+}
+
+// Send writes the alert to the logger
+func (s *LogWatchSink) Send(alert *WatchAlert) error {
+	if alert.TransactionHash != "" {
+		s.logger.Printf("watch rule %s at height %d: %s (transaction %s)", alert.RuleID, alert.Height, alert.Message, alert.TransactionHash)
+		return nil
+	}
+
+	s.logger.Printf("watch rule %s at height %d: %s", alert.RuleID, alert.Height, alert.Message)
+
+	return nil
+}
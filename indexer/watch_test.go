@@ -0,0 +1,236 @@
+package indexer
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pokt-foundation/pocket-go/provider"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	testMock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	watchedAddress      = "00353abd21ef72725b295ba5a9a5eb6082548e21"
+	counterpartyAddress = "00353abd21ef72725b295ba5a9a5eb6082548e22"
+)
+
+type watchDriverMock struct {
+	driverMock
+}
+
+func (d *watchDriverMock) ReadTransactionsByHeight(height int, options *types.ReadTransactionsByHeightOptions) ([]*types.Transaction, error) {
+	args := d.Called(height, options)
+
+	return args.Get(0).([]*types.Transaction), args.Error(1)
+}
+
+func (d *watchDriverMock) ReadAccountByAddress(address string, options *types.ReadAccountByAddressOptions) (*types.Account, error) {
+	args := d.Called(address, options)
+
+	return args.Get(0).(*types.Account), args.Error(1)
+}
+
+func (d *watchDriverMock) ReadNodeByAddress(address string, options *types.ReadNodeByAddressOptions) (*types.Node, error) {
+	args := d.Called(address, options)
+
+	return args.Get(0).(*types.Node), args.Error(1)
+}
+
+func (d *watchDriverMock) ReadAppByAddress(address string, options *types.ReadAppByAddressOptions) (*types.App, error) {
+	args := d.Called(address, options)
+
+	return args.Get(0).(*types.App), args.Error(1)
+}
+
+func TestIndexer_AddWatchRule(t *testing.T) {
+	c := require.New(t)
+
+	indexer := NewIndexer(provider.NewProvider("https://dummy.com", []string{}), &driverMock{})
+
+	c.ErrorIs(indexer.AddWatchRule(nil), ErrInvalidWatchRule)
+	c.ErrorIs(indexer.AddWatchRule(&WatchRule{Kind: AddressActivityWatchRule, Address: watchedAddress}), ErrInvalidWatchRule)
+	c.ErrorIs(indexer.AddWatchRule(&WatchRule{ID: "activity", Kind: AddressActivityWatchRule, Address: "dummy"}), ErrInvalidWatchRule)
+	c.ErrorIs(indexer.AddWatchRule(&WatchRule{ID: "activity", Kind: "transfer", Address: watchedAddress}), ErrInvalidWatchRule)
+	c.ErrorIs(indexer.AddWatchRule(&WatchRule{ID: "balance", Kind: BalanceThresholdWatchRule, Address: watchedAddress}), ErrInvalidWatchRule)
+
+	c.NoError(indexer.AddWatchRule(&WatchRule{ID: "activity", Kind: AddressActivityWatchRule, Address: watchedAddress}))
+	c.ErrorIs(indexer.AddWatchRule(&WatchRule{ID: "activity", Kind: StakeChangedWatchRule, Address: watchedAddress}), ErrDuplicatedWatchRule)
+
+	indexer.RemoveWatchRule("activity")
+	indexer.RemoveWatchRule("unknown")
+
+	c.NoError(indexer.AddWatchRule(&WatchRule{ID: "activity", Kind: StakeChangedWatchRule, Address: watchedAddress}))
+}
+
+func TestIndexer_EvaluateWatchRules(t *testing.T) {
+	c := require.New(t)
+
+	reqProvider := provider.NewProvider("https://dummy.com", []string{})
+
+	indexer := NewIndexer(reqProvider, &driverMock{})
+
+	c.NoError(indexer.EvaluateWatchRules(21))
+
+	c.NoError(indexer.AddWatchRule(&WatchRule{ID: "activity", Kind: AddressActivityWatchRule, Address: watchedAddress}))
+	c.Equal(ErrWatchNotSupported, indexer.EvaluateWatchRules(21))
+
+	driverMock := &watchDriverMock{}
+	indexer = NewIndexer(reqProvider, driverMock)
+
+	alerts := make(chan *WatchAlert, 10)
+	indexer.AddWatchSink(NewChannelWatchSink(alerts))
+
+	// rule addresses are matched lowercase as the stored addresses
+	c.NoError(indexer.AddWatchRule(&WatchRule{ID: "activity", Kind: AddressActivityWatchRule, Address: strings.ToUpper(watchedAddress)}))
+	c.NoError(indexer.AddWatchRule(&WatchRule{ID: "balance", Kind: BalanceThresholdWatchRule, Address: watchedAddress,
+		Threshold: big.NewInt(1000)}))
+	c.NoError(indexer.AddWatchRule(&WatchRule{ID: "wrapped", Kind: BalanceThresholdWatchRule, Address: watchedAddress,
+		Threshold: big.NewInt(3000), Denomination: "uwpokt"}))
+	c.NoError(indexer.AddWatchRule(&WatchRule{ID: "jail", Kind: NodeJailStatusWatchRule, Address: watchedAddress}))
+	c.NoError(indexer.AddWatchRule(&WatchRule{ID: "stake", Kind: StakeChangedWatchRule, Address: watchedAddress}))
+
+	driverMock.On("ReadTransactionsByHeight", 20, testMock.Anything).Return([]*types.Transaction{
+		{Hash: "ABCD", FromAddress: counterpartyAddress, ToAddress: watchedAddress, MessageType: "pos/Send"},
+	}, nil).Once()
+	driverMock.On("ReadAccountByAddress", watchedAddress, &types.ReadAccountByAddressOptions{Height: 20}).
+		Return(&types.Account{Balance: big.NewInt(2000), BalanceDenomination: "upokt",
+			Balances: []*types.Coin{{Denomination: "upokt", Amount: big.NewInt(2000)}}}, nil).Twice()
+	driverMock.On("ReadNodeByAddress", watchedAddress, &types.ReadNodeByAddressOptions{Height: 20}).
+		Return(&types.Node{Jailed: false, Tokens: big.NewInt(15000)}, nil).Twice()
+	driverMock.On("ReadAppByAddress", watchedAddress, &types.ReadAppByAddressOptions{Height: 20}).
+		Return((*types.App)(nil), sql.ErrNoRows).Once()
+
+	c.NoError(indexer.EvaluateWatchRules(20))
+	c.Len(alerts, 1)
+
+	alert := <-alerts
+	c.Equal(&WatchAlert{
+		RuleID:          "activity",
+		Kind:            AddressActivityWatchRule,
+		Address:         watchedAddress,
+		Height:          20,
+		TransactionHash: "ABCD",
+		Message:         "address 00353abd21ef72725b295ba5a9a5eb6082548e21 received transaction pos/Send",
+	}, alert)
+
+	driverMock.On("ReadTransactionsByHeight", 21, testMock.Anything).Return([]*types.Transaction{
+		{Hash: "ABFD", FromAddress: counterpartyAddress, ToAddress: counterpartyAddress, MessageType: "pos/Send"},
+	}, nil).Once()
+	driverMock.On("ReadAccountByAddress", watchedAddress, &types.ReadAccountByAddressOptions{Height: 21}).
+		Return((*types.Account)(nil), sql.ErrNoRows).Twice()
+	driverMock.On("ReadNodeByAddress", watchedAddress, &types.ReadNodeByAddressOptions{Height: 21}).
+		Return(&types.Node{Jailed: true, Tokens: big.NewInt(14000)}, nil).Twice()
+	driverMock.On("ReadAppByAddress", watchedAddress, &types.ReadAppByAddressOptions{Height: 21}).
+		Return((*types.App)(nil), sql.ErrNoRows).Once()
+
+	c.NoError(indexer.EvaluateWatchRules(21))
+	c.Len(alerts, 3)
+	c.Equal("balance fell below threshold 1000: 0", (<-alerts).Message)
+	c.Equal("node jailed", (<-alerts).Message)
+	c.Equal("node stake changed from 15000 to 14000", (<-alerts).Message)
+
+	driverMock.On("ReadTransactionsByHeight", 22, testMock.Anything).Return([]*types.Transaction{}, nil).Once()
+	driverMock.On("ReadAccountByAddress", watchedAddress, &types.ReadAccountByAddressOptions{Height: 22}).
+		Return((*types.Account)(nil), errors.New("forced failure")).Once()
+
+	c.EqualError(indexer.EvaluateWatchRules(22), "forced failure")
+	c.Empty(alerts)
+
+	indexer.AddWatchSink(NewChannelWatchSink(make(chan *WatchAlert)))
+
+	driverMock.On("ReadTransactionsByHeight", 22, testMock.Anything).Return([]*types.Transaction{}, nil).Once()
+	// the rules compare the amount of their denomination, not the first coin
+	driverMock.On("ReadAccountByAddress", watchedAddress, &types.ReadAccountByAddressOptions{Height: 22}).
+		Return(&types.Account{Balance: big.NewInt(1000), BalanceDenomination: "upokt", Balances: []*types.Coin{
+			{Denomination: "uwpokt", Amount: big.NewInt(5000)},
+			{Denomination: "upokt", Amount: big.NewInt(1000)},
+		}}, nil).Twice()
+	driverMock.On("ReadNodeByAddress", watchedAddress, &types.ReadNodeByAddressOptions{Height: 22}).
+		Return(&types.Node{Jailed: true, Tokens: big.NewInt(14000)}, nil).Twice()
+	driverMock.On("ReadAppByAddress", watchedAddress, &types.ReadAppByAddressOptions{Height: 22}).
+		Return((*types.App)(nil), sql.ErrNoRows).Once()
+
+	c.Equal(ErrWatchChannelFull, indexer.EvaluateWatchRules(22))
+	c.Len(alerts, 2)
+	c.Equal("balance rose above threshold 1000: 1000", (<-alerts).Message)
+	c.Equal("balance rose above threshold 3000: 5000", (<-alerts).Message)
+
+	driverMock.AssertExpectations(t)
+}
+
+// registeringWatchSink registers a rule on every alert, which needs the watcher to be unlocked while sending
+type registeringWatchSink struct {
+	indexer *Indexer
+}
+
+func (s *registeringWatchSink) Send(alert *WatchAlert) error {
+	return s.indexer.AddWatchRule(&WatchRule{ID: alert.TransactionHash, Kind: AddressActivityWatchRule, Address: counterpartyAddress})
+}
+
+func TestIndexer_EvaluateWatchRulesUnlocksSinks(t *testing.T) {
+	c := require.New(t)
+
+	driverMock := &watchDriverMock{}
+	indexer := NewIndexer(provider.NewProvider("https://dummy.com", []string{}), driverMock)
+
+	indexer.AddWatchSink(&registeringWatchSink{indexer: indexer})
+
+	c.NoError(indexer.AddWatchRule(&WatchRule{ID: "activity", Kind: AddressActivityWatchRule, Address: watchedAddress}))
+
+	driverMock.On("ReadTransactionsByHeight", 21, testMock.Anything).Return([]*types.Transaction{
+		{Hash: "ABCD", FromAddress: watchedAddress, ToAddress: counterpartyAddress, MessageType: "pos/Send"},
+	}, nil).Once()
+
+	c.NoError(indexer.EvaluateWatchRules(21))
+	c.Len(indexer.watcher.rules, 2)
+
+	driverMock.AssertExpectations(t)
+}
+
+func TestWebhookWatchSink_Send(t *testing.T) {
+	c := require.New(t)
+
+	var received *WatchAlert
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		c.Equal("application/json", r.Header.Get("Content-Type"))
+		c.NoError(json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	alert := &WatchAlert{RuleID: "jail", Kind: NodeJailStatusWatchRule, Address: watchedAddress, Height: 21, Message: "node jailed"}
+
+	c.NoError(NewWebhookWatchSink(server.URL, nil).Send(alert))
+	c.Equal(alert, received)
+
+	err := NewWebhookWatchSink(server.URL+"/fail", server.Client()).Send(alert)
+	c.ErrorIs(err, ErrWebhookStatus)
+	c.EqualError(err, "webhook responded with non success status: 500")
+}
+
+func TestLogWatchSink_Send(t *testing.T) {
+	c := require.New(t)
+
+	var output bytes.Buffer
+
+	sink := NewLogWatchSink(log.New(&output, "", 0))
+
+	c.NoError(sink.Send(&WatchAlert{RuleID: "jail", Height: 21, Message: "node jailed"}))
+	c.NoError(sink.Send(&WatchAlert{RuleID: "activity", Height: 21, Message: "address received", TransactionHash: "ABCD"}))
+	c.Equal("watch rule jail at height 21: node jailed\nwatch rule activity at height 21: address received (transaction ABCD)\n",
+		output.String())
+}
@@ -0,0 +1,166 @@
+package indexer
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+// WebhookOutbox interface of the durable storage of the webhook deliveries
+type WebhookOutbox interface {
+	EnqueueWebhookDeliveries(tx *sql.Tx, deliveries []*types.WebhookDelivery) error
+	ClaimWebhookDeliveries(limit int, lease time.Duration) ([]*types.WebhookDelivery, error)
+	UpdateWebhookDelivery(delivery *types.WebhookDelivery) error
+}
+
+// heightWebhookPayload is the body posted for an indexed height
+type heightWebhookPayload struct {
+	Event                types.WebhookEventType `json:"event"`
+	Height               int                    `json:"height"`
+	Hash                 string                 `json:"hash"`
+	Time                 time.Time              `json:"time"`
+	TransactionsQuantity int                    `json:"transactions_quantity"`
+}
+
+// transactionWebhookPayload is the body posted for an indexed transaction
+type transactionWebhookPayload struct {
+	Event           types.WebhookEventType `json:"event"`
+	Height          int                    `json:"height"`
+	Hash            string                 `json:"hash"`
+	Index           int                    `json:"index"`
+	MessageType     string                 `json:"message_type"`
+	FromAddress     string                 `json:"from_address,omitempty"`
+	ToAddress       string                 `json:"to_address,omitempty"`
+	SignerAddress   string                 `json:"signer_address,omitempty"`
+	Amount          string                 `json:"amount"`
+	Fee             string                 `json:"fee"`
+	FeeDenomination string                 `json:"fee_denomination"`
+	Memo            string                 `json:"memo,omitempty"`
+}
+
+// WebhookPlugin is a Plugin enqueueing webhook deliveries of the indexed heights and transactions
+// deliveries are enqueued in the height transaction, so every committed height is delivered at least once
+type WebhookPlugin struct {
+	outbox WebhookOutbox
+	events map[types.WebhookEventType]bool
+}
+
+// NewWebhookPlugin returns WebhookPlugin instance enqueueing given events to the outbox
+// no events defaults to height and transaction events
+func NewWebhookPlugin(outbox WebhookOutbox, events ...types.WebhookEventType) *WebhookPlugin {
+	if len(events) == 0 {
+		events = []types.WebhookEventType{types.HeightWebhookEvent, types.TransactionWebhookEvent}
+	}
+
+	plugin := &WebhookPlugin{
+		outbox: outbox,
+		events: make(map[types.WebhookEventType]bool),
+	}
+
+	for _, event := range events {
+		plugin.events[event] = true
+	}
+
+	return plugin
+}
+
+// BeforeWrite does nothing, the plugin only enqueues the written data
+func (p *WebhookPlugin) BeforeWrite(height int, data *types.HeightData) error {
+	return nil
+}
+
+// Write enqueues the deliveries of the height in the height transaction
+func (p *WebhookPlugin) Write(height int, data *types.HeightData, tx *sql.Tx) error {
+	var deliveries []*types.WebhookDelivery
+
+	if p.events[types.HeightWebhookEvent] {
+		delivery, err := newWebhookDelivery(types.HeightWebhookEvent, height, &heightWebhookPayload{
+			Event:                types.HeightWebhookEvent,
+			Height:               height,
+			Hash:                 data.Block.Hash,
+			Time:                 data.Block.Time,
+			TransactionsQuantity: len(data.Transactions),
+		})
+		if err != nil {
+			return err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if p.events[types.TransactionWebhookEvent] {
+		for _, transaction := range data.Transactions {
+			delivery, err := newWebhookDelivery(types.TransactionWebhookEvent, height, newTransactionWebhookPayload(transaction))
+			if err != nil {
+				return err
+			}
+
+			deliveries = append(deliveries, delivery)
+		}
+	}
+
+	return p.outbox.EnqueueWebhookDeliveries(tx, deliveries)
+}
+
+// AfterCommit does nothing, deliveries are sent by a WebhookDispatcher
+func (p *WebhookPlugin) AfterCommit(height int) error {
+	return nil
+}
+
+func newTransactionWebhookPayload(transaction *types.Transaction) *transactionWebhookPayload {
+	payload := &transactionWebhookPayload{
+		Event:           types.TransactionWebhookEvent,
+		Height:          transaction.Height,
+		Hash:            transaction.Hash,
+		Index:           transaction.Index,
+		MessageType:     transaction.MessageType,
+		FromAddress:     transaction.FromAddress,
+		ToAddress:       transaction.ToAddress,
+		SignerAddress:   transaction.SignerAddress,
+		Amount:          "0",
+		Fee:             "0",
+		FeeDenomination: transaction.FeeDenomination,
+		Memo:            transaction.Memo,
+	}
+
+	if transaction.Amount != nil {
+		payload.Amount = transaction.Amount.String()
+	}
+
+	if transaction.Fee != nil {
+		payload.Fee = transaction.Fee.String()
+	}
+
+	return payload
+}
+
+func newWebhookDelivery(event types.WebhookEventType, height int, payload any) (*types.WebhookDelivery, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.WebhookDelivery{
+		EventType: event,
+		Height:    height,
+		Payload:   body,
+		Status:    types.PendingWebhookDelivery,
+	}, nil
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 of the timestamp and body with given secret
+// it is the value of the signature header, receivers recompute it to verify the sender and reject stale timestamps
+func SignWebhookPayload(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
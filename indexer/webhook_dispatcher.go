@@ -0,0 +1,245 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	defaultWebhookBatchSize    = 100
+	defaultWebhookMaxAttempts  = 10
+	defaultWebhookMinBackoff   = 5 * time.Second
+	defaultWebhookMaxBackoff   = time.Hour
+	defaultWebhookLeaseMargin  = time.Minute
+	defaultWebhookPollInterval = 5 * time.Second
+
+	// WebhookSignatureHeader is the header holding the SignWebhookPayload signature of a delivery
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	// WebhookTimestampHeader is the header holding the unix timestamp signed with the delivery
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	// WebhookDeliveryIDHeader is the header holding the delivery ID, retried deliveries keep it
+	WebhookDeliveryIDHeader = "X-Webhook-Delivery-ID"
+	// WebhookEventHeader is the header holding the delivery event type
+	WebhookEventHeader = "X-Webhook-Event"
+)
+
+var (
+	// ErrInvalidWebhookConfig error when the webhook dispatcher config is missing values
+	ErrInvalidWebhookConfig = errors.New("invalid webhook config")
+)
+
+// WebhookDispatcherConfig struct handler of the webhook dispatcher settings
+// Optional values defaults: client: 10 seconds timeout, batchSize: 100, maxAttempts: 10,
+// minBackoff: 5 seconds, maxBackoff: 1 hour, lease: batchSize x client timeout + 1 minute, pollInterval: 5 seconds
+// the batch is posted one delivery at a time, so the lease can't be shorter than batchSize x client timeout
+// and the client must have a timeout, otherwise deliveries still being posted could be claimed again
+type WebhookDispatcherConfig struct {
+	URL          string
+	Secret       string
+	Client       *http.Client
+	BatchSize    int
+	MaxAttempts  int
+	MinBackoff   time.Duration
+	MaxBackoff   time.Duration
+	Lease        time.Duration
+	PollInterval time.Duration
+}
+
+func (c *WebhookDispatcherConfig) setDefaults() {
+	if c.Client == nil {
+		c.Client = &http.Client{Timeout: defaultWebhookTimeout}
+	}
+
+	if c.BatchSize <= 0 {
+		c.BatchSize = defaultWebhookBatchSize
+	}
+
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = defaultWebhookMaxAttempts
+	}
+
+	if c.MinBackoff <= 0 {
+		c.MinBackoff = defaultWebhookMinBackoff
+	}
+
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = defaultWebhookMaxBackoff
+	}
+
+	if c.Lease <= 0 {
+		c.Lease = c.getMinLease() + defaultWebhookLeaseMargin
+	}
+
+	if c.PollInterval <= 0 {
+		c.PollInterval = defaultWebhookPollInterval
+	}
+}
+
+// getMinLease returns the time to post a whole batch when every post reaches the client timeout
+func (c *WebhookDispatcherConfig) getMinLease() time.Duration {
+	return time.Duration(c.BatchSize) * c.Client.Timeout
+}
+
+// validateLease checks the lease outlasts the posts of a whole batch
+func (c *WebhookDispatcherConfig) validateLease() error {
+	if c.Client.Timeout <= 0 {
+		return fmt.Errorf("%w: client without timeout", ErrInvalidWebhookConfig)
+	}
+
+	minLease := c.getMinLease()
+	if c.Lease < minLease {
+		return fmt.Errorf("%w: lease %s shorter than batch size x client timeout %s", ErrInvalidWebhookConfig, c.Lease, minLease)
+	}
+
+	return nil
+}
+
+// WebhookDispatcher posts the outbox deliveries to a webhook
+// failed deliveries are retried with exponential backoff and are dead after the max attempts
+type WebhookDispatcher struct {
+	outbox WebhookOutbox
+	config WebhookDispatcherConfig
+}
+
+// NewWebhookDispatcher returns WebhookDispatcher instance posting the outbox deliveries
+// returns ErrInvalidWebhookConfig if the URL is not absolute, the secret is empty
+// or the lease is shorter than the posts of a whole batch
+func NewWebhookDispatcher(outbox WebhookOutbox, config WebhookDispatcherConfig) (*WebhookDispatcher, error) {
+	webhookURL, err := url.Parse(config.URL)
+	if err != nil || !webhookURL.IsAbs() {
+		return nil, fmt.Errorf("%w: invalid URL: %q", ErrInvalidWebhookConfig, config.URL)
+	}
+
+	if config.Secret == "" {
+		return nil, fmt.Errorf("%w: missing secret", ErrInvalidWebhookConfig)
+	}
+
+	config.setDefaults()
+
+	err = config.validateLease()
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookDispatcher{
+		outbox: outbox,
+		config: config,
+	}, nil
+}
+
+// Run dispatches the pending deliveries until the context is done
+// waits the poll interval when there are no deliveries, returns the context error or the first outbox error
+func (d *WebhookDispatcher) Run(ctx context.Context) error {
+	for {
+		claimed, err := d.DispatchPending(ctx)
+		if err != nil {
+			return err
+		}
+
+		if claimed == d.config.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d.config.PollInterval):
+		}
+	}
+}
+
+// DispatchPending posts one batch of the due pending deliveries and saves their results
+// returns the number of deliveries claimed, failed posts are scheduled for retry and are not errors
+// on an update error returns the number of deliveries already saved
+func (d *WebhookDispatcher) DispatchPending(ctx context.Context) (int, error) {
+	deliveries, err := d.outbox.ClaimWebhookDeliveries(d.config.BatchSize, d.config.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for saved, delivery := range deliveries {
+		err = d.post(ctx, delivery)
+		if ctx.Err() != nil {
+			// unsent deliveries are claimed again when their lease ends
+			return len(deliveries), ctx.Err()
+		}
+
+		if err != nil {
+			d.setFailure(delivery, err)
+		} else {
+			delivery.Status = types.DeliveredWebhookDelivery
+			delivery.DeliveredAt = time.Now()
+			delivery.LastError = ""
+		}
+
+		err = d.outbox.UpdateWebhookDelivery(delivery)
+		if err != nil {
+			return saved, err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+func (d *WebhookDispatcher) post(ctx context.Context, delivery *types.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.config.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(d.config.Secret, timestamp, delivery.Payload))
+	req.Header.Set(WebhookDeliveryIDHeader, strconv.Itoa(delivery.ID))
+	req.Header.Set(WebhookEventHeader, string(delivery.EventType))
+
+	resp, err := d.config.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %d", ErrWebhookStatus, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// setFailure schedules the next attempt of the delivery or sets it dead when it has no attempts left
+func (d *WebhookDispatcher) setFailure(delivery *types.WebhookDelivery, err error) {
+	delivery.LastError = err.Error()
+
+	if delivery.Attempts >= d.config.MaxAttempts {
+		delivery.Status = types.DeadWebhookDelivery
+		return
+	}
+
+	delivery.Status = types.PendingWebhookDelivery
+	delivery.NextAttemptAt = time.Now().Add(d.getBackoff(delivery.Attempts))
+}
+
+// getBackoff returns the wait after the given attempt, doubled on every attempt up to the max backoff
+func (d *WebhookDispatcher) getBackoff(attempts int) time.Duration {
+	backoff := d.config.MinBackoff
+
+	for attempt := 1; attempt < attempts && backoff < d.config.MaxBackoff; attempt++ {
+		backoff *= 2
+	}
+
+	if backoff > d.config.MaxBackoff {
+		return d.config.MaxBackoff
+	}
+
+	return backoff
+}
@@ -0,0 +1,253 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	testMock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type webhookOutboxMock struct {
+	testMock.Mock
+}
+
+func (o *webhookOutboxMock) EnqueueWebhookDeliveries(tx *sql.Tx, deliveries []*types.WebhookDelivery) error {
+	args := o.Called(tx, deliveries)
+
+	return args.Error(0)
+}
+
+func (o *webhookOutboxMock) ClaimWebhookDeliveries(limit int, lease time.Duration) ([]*types.WebhookDelivery, error) {
+	args := o.Called(limit, lease)
+
+	return args.Get(0).([]*types.WebhookDelivery), args.Error(1)
+}
+
+func (o *webhookOutboxMock) UpdateWebhookDelivery(delivery *types.WebhookDelivery) error {
+	args := o.Called(delivery)
+
+	return args.Error(0)
+}
+
+func TestWebhookPlugin_Write(t *testing.T) {
+	c := require.New(t)
+
+	data := &types.HeightData{
+		Height: 21,
+		Block: &types.Block{
+			Hash:   "ABCD",
+			Height: 21,
+			Time:   time.Date(2022, time.June, 21, 0, 0, 0, 0, time.UTC),
+		},
+		Transactions: []*types.Transaction{
+			{
+				Hash:        "EFGH",
+				Height:      21,
+				MessageType: "send",
+				FromAddress: "00353abd21ef72725b295ba5a9a5eb6082548e21",
+				ToAddress:   "00353abd21ef72725b295ba5a9a5eb6082548e22",
+				Amount:      big.NewInt(212121),
+			},
+		},
+	}
+
+	outbox := &webhookOutboxMock{}
+
+	var deliveries []*types.WebhookDelivery
+
+	outbox.On("EnqueueWebhookDeliveries", (*sql.Tx)(nil), testMock.Anything).Run(func(args testMock.Arguments) {
+		deliveries = args.Get(1).([]*types.WebhookDelivery)
+	}).Return(nil)
+
+	plugin := NewWebhookPlugin(outbox)
+
+	c.NoError(plugin.BeforeWrite(21, data))
+	c.NoError(plugin.Write(21, data, nil))
+	c.NoError(plugin.AfterCommit(21))
+
+	c.Len(deliveries, 2)
+	c.Equal(types.HeightWebhookEvent, deliveries[0].EventType)
+	c.Equal(types.PendingWebhookDelivery, deliveries[0].Status)
+	c.JSONEq(`{"event":"height","height":21,"hash":"ABCD","time":"2022-06-21T00:00:00Z","transactions_quantity":1}`,
+		string(deliveries[0].Payload))
+	c.Equal(types.TransactionWebhookEvent, deliveries[1].EventType)
+	c.JSONEq(`{"event":"transaction","height":21,"hash":"EFGH","index":0,"message_type":"send",
+		"from_address":"00353abd21ef72725b295ba5a9a5eb6082548e21","to_address":"00353abd21ef72725b295ba5a9a5eb6082548e22",
+		"amount":"212121","fee":"0","fee_denomination":""}`, string(deliveries[1].Payload))
+
+	plugin = NewWebhookPlugin(outbox, types.TransactionWebhookEvent)
+
+	c.NoError(plugin.Write(21, data, nil))
+	c.Len(deliveries, 1)
+	c.Equal(types.TransactionWebhookEvent, deliveries[0].EventType)
+
+	outbox = &webhookOutboxMock{}
+	outbox.On("EnqueueWebhookDeliveries", (*sql.Tx)(nil), testMock.Anything).Return(errors.New("dummy error"))
+
+	plugin = NewWebhookPlugin(outbox)
+
+	c.EqualError(plugin.Write(21, data, nil), "dummy error")
+}
+
+func TestNewWebhookDispatcher(t *testing.T) {
+	c := require.New(t)
+
+	_, err := NewWebhookDispatcher(&webhookOutboxMock{}, WebhookDispatcherConfig{URL: "/hooks", Secret: "secret"})
+	c.ErrorIs(err, ErrInvalidWebhookConfig)
+
+	_, err = NewWebhookDispatcher(&webhookOutboxMock{}, WebhookDispatcherConfig{URL: "https://dummy.com/hooks"})
+	c.ErrorIs(err, ErrInvalidWebhookConfig)
+
+	dispatcher, err := NewWebhookDispatcher(&webhookOutboxMock{}, WebhookDispatcherConfig{URL: "https://dummy.com/hooks", Secret: "secret"})
+	c.NoError(err)
+	c.NotNil(dispatcher.config.Client)
+	c.Equal(defaultWebhookBatchSize, dispatcher.config.BatchSize)
+	c.Equal(defaultWebhookMaxAttempts, dispatcher.config.MaxAttempts)
+	c.Equal(1000*time.Second+time.Minute, dispatcher.config.Lease)
+
+	_, err = NewWebhookDispatcher(&webhookOutboxMock{}, WebhookDispatcherConfig{
+		URL:    "https://dummy.com/hooks",
+		Secret: "secret",
+		Lease:  time.Minute,
+	})
+	c.ErrorIs(err, ErrInvalidWebhookConfig)
+
+	_, err = NewWebhookDispatcher(&webhookOutboxMock{}, WebhookDispatcherConfig{
+		URL:    "https://dummy.com/hooks",
+		Secret: "secret",
+		Client: &http.Client{},
+	})
+	c.ErrorIs(err, ErrInvalidWebhookConfig)
+
+	dispatcher, err = NewWebhookDispatcher(&webhookOutboxMock{}, WebhookDispatcherConfig{
+		URL:       "https://dummy.com/hooks",
+		Secret:    "secret",
+		Client:    &http.Client{Timeout: time.Second},
+		BatchSize: 10,
+		Lease:     10 * time.Second,
+	})
+	c.NoError(err)
+	c.Equal(10*time.Second, dispatcher.config.Lease)
+
+	c.Equal(5*time.Second, dispatcher.getBackoff(1))
+	c.Equal(10*time.Second, dispatcher.getBackoff(2))
+	c.Equal(40*time.Second, dispatcher.getBackoff(4))
+	c.Equal(time.Hour, dispatcher.getBackoff(20))
+}
+
+func TestWebhookDispatcher_DispatchPending(t *testing.T) {
+	c := require.New(t)
+
+	statuses := []int{http.StatusOK, http.StatusInternalServerError, http.StatusBadGateway}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		unix, _ := strconv.ParseInt(r.Header.Get(WebhookTimestampHeader), 10, 64)
+
+		if r.Header.Get(WebhookSignatureHeader) != SignWebhookPayload("secret", time.Unix(unix, 0), body) ||
+			r.Header.Get(WebhookEventHeader) != "height" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		id, _ := strconv.Atoi(r.Header.Get(WebhookDeliveryIDHeader))
+		w.WriteHeader(statuses[id-1])
+	}))
+	defer server.Close()
+
+	outbox := &webhookOutboxMock{}
+
+	dispatcher, err := NewWebhookDispatcher(outbox, WebhookDispatcherConfig{
+		URL:         server.URL,
+		Secret:      "secret",
+		MaxAttempts: 3,
+	})
+	c.NoError(err)
+
+	deliveries := []*types.WebhookDelivery{
+		{ID: 1, EventType: types.HeightWebhookEvent, Payload: []byte(`{"height":21}`), Attempts: 1},
+		{ID: 2, EventType: types.HeightWebhookEvent, Payload: []byte(`{"height":22}`), Attempts: 1},
+		{ID: 3, EventType: types.HeightWebhookEvent, Payload: []byte(`{"height":23}`), Attempts: 3},
+	}
+
+	outbox.On("ClaimWebhookDeliveries", defaultWebhookBatchSize, dispatcher.config.Lease).Return(deliveries, nil).Once()
+	outbox.On("UpdateWebhookDelivery", testMock.Anything).Return(nil).Times(3)
+
+	before := time.Now()
+
+	claimed, err := dispatcher.DispatchPending(context.Background())
+	c.NoError(err)
+	c.Equal(3, claimed)
+
+	c.Equal(types.DeliveredWebhookDelivery, deliveries[0].Status)
+	c.False(deliveries[0].DeliveredAt.IsZero())
+	c.Empty(deliveries[0].LastError)
+
+	c.Equal(types.PendingWebhookDelivery, deliveries[1].Status)
+	c.True(deliveries[1].NextAttemptAt.After(before.Add(defaultWebhookMinBackoff - time.Second)))
+	c.Contains(deliveries[1].LastError, ErrWebhookStatus.Error())
+
+	c.Equal(types.DeadWebhookDelivery, deliveries[2].Status)
+	c.Contains(deliveries[2].LastError, "502")
+
+	outbox.On("ClaimWebhookDeliveries", defaultWebhookBatchSize, dispatcher.config.Lease).Return(deliveries[:2], nil).Once()
+	outbox.On("UpdateWebhookDelivery", deliveries[0]).Return(nil).Once()
+	outbox.On("UpdateWebhookDelivery", deliveries[1]).Return(errors.New("dummy error")).Once()
+
+	saved, err := dispatcher.DispatchPending(context.Background())
+	c.EqualError(err, "dummy error")
+	c.Equal(1, saved)
+
+	outbox.On("ClaimWebhookDeliveries", defaultWebhookBatchSize, dispatcher.config.Lease).
+		Return([]*types.WebhookDelivery(nil), errors.New("dummy error")).Once()
+
+	_, err = dispatcher.DispatchPending(context.Background())
+	c.EqualError(err, "dummy error")
+
+	outbox.AssertExpectations(t)
+}
+
+func TestWebhookDispatcher_Run(t *testing.T) {
+	c := require.New(t)
+
+	outbox := &webhookOutboxMock{}
+
+	dispatcher, err := NewWebhookDispatcher(outbox, WebhookDispatcherConfig{
+		URL:          "https://dummy.com/hooks",
+		Secret:       "secret",
+		PollInterval: time.Millisecond,
+	})
+	c.NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	outbox.On("ClaimWebhookDeliveries", defaultWebhookBatchSize, dispatcher.config.Lease).
+		Return([]*types.WebhookDelivery(nil), nil).Once()
+	outbox.On("ClaimWebhookDeliveries", defaultWebhookBatchSize, dispatcher.config.Lease).Run(func(args testMock.Arguments) {
+		cancel()
+	}).Return([]*types.WebhookDelivery(nil), nil).Once()
+
+	c.ErrorIs(dispatcher.Run(ctx), context.Canceled)
+
+	outbox.On("ClaimWebhookDeliveries", defaultWebhookBatchSize, dispatcher.config.Lease).
+		Return([]*types.WebhookDelivery(nil), errors.New("dummy error")).Once()
+
+	c.EqualError(dispatcher.Run(context.Background()), "dummy error")
+
+	outbox.AssertExpectations(t)
+}
@@ -1,34 +1,36 @@
 package postgresdriver
 
 import (
+	"fmt"
 	"math/big"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"github.com/pokt-foundation/pocket-indexer-lib/types"
 )
 
 const (
 	insertAccountsScript = `
-	INSERT into accounts (address, height, balance, balance_denomination)
+	INSERT into accounts (address, height, balance, balance_denomination, balances)
 	(
-		select * from unnest($1::text[], $2::int[], $3::numeric[], $4::text[])
+		select * from unnest($1::text[], $2::int[], $3::numeric[], $4::text[], $5::jsonb[])
 	)`
-	selectAccountsScript = `
-	DECLARE accounts_cursor CURSOR FOR SELECT * FROM accounts WHERE height = (SELECT MAX(height) FROM accounts);
-	MOVE absolute %d from accounts_cursor;
-	FETCH %d FROM accounts_cursor;
-	`
-	selectAccountsByHeightScript = `
-	DECLARE accounts_cursor CURSOR FOR SELECT * FROM accounts WHERE height = '%d';
-	MOVE absolute %d from accounts_cursor;
-	FETCH %d FROM accounts_cursor;
-	`
 	selectAccountByAddressScript          = "SELECT * FROM accounts WHERE address = $1 AND height = (SELECT MAX(height) FROM accounts)"
 	selectAccountByAddressAndHeightScript = "SELECT * FROM accounts WHERE address = $1 AND height = $2"
 	selectCountFromAccounts               = "SELECT COUNT(*) FROM accounts WHERE height = (SELECT MAX(height) FROM accounts)"
 	selectCountFromAccountsByHeight       = "SELECT COUNT(*) FROM accounts WHERE height = $1"
+
+	// accounts saved before balances was added only have their first coin on balance and balance_denomination
+	holdsDenominationCondition = `(balances @> jsonb_build_array(jsonb_build_object('denom', %[1]s::text))
+		OR (balances IS NULL AND balance_denomination = %[1]s))`
+	denominationBalanceExpression = `COALESCE((SELECT SUM((coin->>'amount')::numeric) FROM jsonb_array_elements(balances) AS coin
+		WHERE coin->>'denom' = %[1]s), balance)`
 )
 
+var accountSortColumns = map[types.AccountSortField]string{
+	types.AccountSortByBalance: "balance",
+}
+
 // dbAccount is struct handler for the account with types needed for Postgres processing
 type dbAccount struct {
 	ID                  int    `db:"id"`
@@ -36,6 +38,7 @@ type dbAccount struct {
 	Height              int    `db:"height"`
 	Balance             string `db:"balance"`
 	BalanceDenomination string `db:"balance_denomination"`
+	Balances            coins  `db:"balances"`
 }
 
 func (a *dbAccount) toIndexerAccount() *types.Account {
@@ -47,6 +50,7 @@ func (a *dbAccount) toIndexerAccount() *types.Account {
 		Height:              a.Height,
 		Balance:             balance,
 		BalanceDenomination: a.BalanceDenomination,
+		Balances:            a.Balances.toIndexerCoins(),
 	}
 }
 
@@ -56,13 +60,24 @@ func convertIndexerAccountToDBAccount(indexerAccount *types.Account) *dbAccount
 		Height:              indexerAccount.Height,
 		Balance:             indexerAccount.Balance.String(),
 		BalanceDenomination: indexerAccount.BalanceDenomination,
+		Balances:            convertIndexerCoinsToDBCoins(indexerAccount.Balances),
 	}
 }
 
 // WriteAccounts inserts given accounts to the database
 func (d *PostgresDriver) WriteAccounts(accounts []*types.Account) error {
+	return writeAccounts(d, accounts)
+}
+
+// writeAccounts inserts nothing when there are no accounts
+func writeAccounts(db sqlx.Execer, accounts []*types.Account) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+
 	var addresses, balanceDenominations, balances []string
 	var heights []int64
+	var allBalances []coins
 
 	for _, account := range accounts {
 		account := convertIndexerAccountToDBAccount(account)
@@ -70,12 +85,14 @@ func (d *PostgresDriver) WriteAccounts(accounts []*types.Account) error {
 		balanceDenominations = append(balanceDenominations, account.BalanceDenomination)
 		heights = append(heights, int64(account.Height))
 		balances = append(balances, account.Balance)
+		allBalances = append(allBalances, account.Balances)
 	}
 
-	_, err := d.Exec(insertAccountsScript, pq.StringArray(addresses),
+	_, err := db.Exec(insertAccountsScript, pq.StringArray(addresses),
 		pq.Int64Array(heights),
 		pq.StringArray(balances),
-		pq.StringArray(balanceDenominations))
+		pq.StringArray(balanceDenominations),
+		pq.Array(allBalances))
 	if err != nil {
 		return err
 	}
@@ -93,12 +110,12 @@ func (d *PostgresDriver) ReadAccountByAddress(address string, options *types.Rea
 	}
 
 	if height == 0 {
-		err := d.Get(&dbAccount, selectAccountByAddressScript, address)
+		err := d.reader().Get(&dbAccount, selectAccountByAddressScript, address)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		err := d.Get(&dbAccount, selectAccountByAddressAndHeightScript, address, height)
+		err := d.reader().Get(&dbAccount, selectAccountByAddressAndHeightScript, address, height)
 		if err != nil {
 			return nil, err
 		}
@@ -107,37 +124,79 @@ func (d *PostgresDriver) ReadAccountByAddress(address string, options *types.Rea
 	return dbAccount.toIndexerAccount(), nil
 }
 
-// ReadAccounts returns accounts with given height
-// Optional values defaults: page: 1, perPage: 1000, height: last height
-func (d *PostgresDriver) ReadAccounts(options *types.ReadAccountsOptions) ([]*types.Account, error) {
-	perPage := defaultPerPage
-	page := defaultPage
-	height := 0
+// addDenominationFilter filters the accounts holding given denomination
+// returns the expression of the accounts balance of the denomination, empty without denomination
+// the native denomination is read from the balance column so its sort and filters can use the accounts indexes
+func addDenominationFilter(filters *filterQuery, denomination string) string {
+	switch denomination {
+	case "":
+		return ""
+	case types.NativeDenomination:
+		filters.addFilter("balance_denomination = %s", denomination)
+
+		return accountSortColumns[types.AccountSortByBalance]
+	}
 
-	if options != nil {
-		perPage = getPerPageValue(options.PerPage)
-		page = getPageValue(options.Page)
-		height = options.Height
+	placeholder := filters.addArg(denomination)
+	filters.addCondition(fmt.Sprintf(holdsDenominationCondition, placeholder))
+
+	return fmt.Sprintf(denominationBalanceExpression, placeholder)
+}
+
+// getAccountsDenomination returns the options denomination, balance sort and MinBalance default to the native one
+func getAccountsDenomination(options *types.ReadAccountsOptions) string {
+	if options.Denomination == "" && (options.MinBalance != nil || options.SortBy == types.AccountSortByBalance) {
+		return types.NativeDenomination
 	}
 
-	move := getMoveValue(perPage, page)
+	return options.Denomination
+}
 
-	tx, err := d.Beginx()
+func getAccountsPageQuery(options *types.ReadAccountsOptions) (string, []any, error) {
+	sortColumn, ok := accountSortColumns[options.SortBy]
+	if !ok && options.SortBy != "" {
+		return "", nil, fmt.Errorf("%w: %q", ErrInvalidSortField, options.SortBy)
+	}
+
+	filters := &filterQuery{}
+	filters.addHeightFilter("accounts", options.Height)
+
+	balanceColumn := addDenominationFilter(filters, getAccountsDenomination(options))
+
+	if options.MinBalance != nil {
+		filters.addFilter(balanceColumn+" >= %s::numeric", options.MinBalance.String())
+	}
+
+	if options.SortBy == types.AccountSortByBalance {
+		sortColumn = balanceColumn
+	}
+
+	sort, err := getSortValue(sortColumn, options.Order)
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
 
-	query := getHeightOptionalQuery(selectAccountsByHeightScript, selectAccountsScript,
-		height, move, perPage)
+	perPage := getPerPageValue(options.PerPage)
+	query, args := buildPageQuery("accounts", filters, sort, perPage, getMoveValue(perPage, getPageValue(options.Page)))
 
-	var accounts []*dbAccount
+	return query, args, nil
+}
+
+// ReadAccounts returns accounts with given height
+// Optional values defaults: page: 1, perPage: 1000, height: last height, order: desc when sorted
+func (d *PostgresDriver) ReadAccounts(options *types.ReadAccountsOptions) ([]*types.Account, error) {
+	if options == nil {
+		options = &types.ReadAccountsOptions{}
+	}
 
-	err = tx.Select(&accounts, query)
+	query, args, err := getAccountsPageQuery(options)
 	if err != nil {
 		return nil, err
 	}
 
-	err = tx.Commit()
+	var accounts []*dbAccount
+
+	err = d.reader().Select(&accounts, query, args...)
 	if err != nil {
 		return nil, err
 	}
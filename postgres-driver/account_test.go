@@ -20,7 +20,8 @@ func TestPostgresDriver_WriteAccounts(t *testing.T) {
 	defer db.Close()
 
 	mock.ExpectExec("INSERT into accounts").WithArgs(pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}),
-		pq.Int64Array([]int64{21}), pq.StringArray([]string{"212121"}), pq.StringArray([]string{"upokt"})).
+		pq.Int64Array([]int64{21}), pq.StringArray([]string{"212121"}), pq.StringArray([]string{"upokt"}),
+		pq.StringArray([]string{`[{"amount":"212121","denom":"upokt"},{"amount":"21","denom":"uwpokt"}]`})).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	driver := NewPostgresDriverFromSQLDBInstance(db)
@@ -31,12 +32,17 @@ func TestPostgresDriver_WriteAccounts(t *testing.T) {
 			Height:              21,
 			Balance:             big.NewInt(212121),
 			BalanceDenomination: "upokt",
+			Balances: []*types.Coin{
+				{Denomination: "upokt", Amount: big.NewInt(212121)},
+				{Denomination: "uwpokt", Amount: big.NewInt(21)},
+			},
 		},
 	})
 	c.NoError(err)
 
 	mock.ExpectExec("INSERT into accounts").WithArgs(pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}),
-		pq.Int64Array([]int64{21}), pq.StringArray([]string{"212121"}), pq.StringArray([]string{"upokt"})).
+		pq.Int64Array([]int64{21}), pq.StringArray([]string{"212121"}), pq.StringArray([]string{"upokt"}),
+		pq.StringArray([]string{`[{"amount":"212121","denom":"upokt"},{"amount":"21","denom":"uwpokt"}]`})).
 		WillReturnError(errors.New("dummy error"))
 
 	err = driver.WriteAccounts([]*types.Account{
@@ -45,6 +51,10 @@ func TestPostgresDriver_WriteAccounts(t *testing.T) {
 			Height:              21,
 			Balance:             big.NewInt(212121),
 			BalanceDenomination: "upokt",
+			Balances: []*types.Coin{
+				{Denomination: "upokt", Amount: big.NewInt(212121)},
+				{Denomination: "uwpokt", Amount: big.NewInt(21)},
+			},
 		},
 	})
 	c.EqualError(err, "dummy error")
@@ -103,9 +113,7 @@ func TestPostgresDriver_ReadAccounts(t *testing.T) {
 		AddRow(1, "00353abd21ef72725b295ba5a9a5eb6082548e21", 21, "212121", "upokt").
 		AddRow(2, "00353abd21ef72725b295ba5a9a5eb6082548e22", 21, "212121", "upokt")
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnRows(rows)
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM accounts WHERE height = \\$1").WithArgs(21, 7, 140).WillReturnRows(rows)
 
 	driver := NewPostgresDriverFromSQLDBInstance(db)
 
@@ -113,13 +121,71 @@ func TestPostgresDriver_ReadAccounts(t *testing.T) {
 	c.NoError(err)
 	c.Len(accounts, 2)
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnError(errors.New("dummy error"))
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM accounts WHERE height = \\(SELECT MAX").WithArgs(1000, 0).WillReturnError(errors.New("dummy error"))
 
 	accounts, err = driver.ReadAccounts(&types.ReadAccountsOptions{})
 	c.EqualError(err, "dummy error")
 	c.Empty(accounts)
+
+	rows = sqlmock.NewRows([]string{"id", "address", "height", "balance", "balance_denomination", "balances"}).
+		AddRow(1, "00353abd21ef72725b295ba5a9a5eb6082548e21", 21, "212121", "upokt", []byte(`[{"amount":"21","denom":"uwpokt"},{"amount":"212121","denom":"upokt"}]`))
+
+	mock.ExpectQuery("^SELECT \\* FROM accounts WHERE height = \\$1 AND \\(balances @> jsonb_build_array\\(jsonb_build_object\\('denom', \\$2::text\\)\\)\\s+"+
+		"OR \\(balances IS NULL AND balance_denomination = \\$2\\)\\) AND COALESCE\\(\\(SELECT SUM(.+) WHERE coin->>'denom' = \\$2\\), balance\\) >= \\$3::numeric "+
+		"ORDER BY COALESCE\\(\\(SELECT SUM(.+) WHERE coin->>'denom' = \\$2\\), balance\\) DESC, address LIMIT \\$4 OFFSET \\$5$").
+		WithArgs(21, "uwpokt", "10", 10, 0).WillReturnRows(rows)
+
+	accounts, err = driver.ReadAccounts(&types.ReadAccountsOptions{
+		PerPage:      10,
+		Height:       21,
+		SortBy:       types.AccountSortByBalance,
+		Denomination: "uwpokt",
+		MinBalance:   big.NewInt(10),
+	})
+	c.NoError(err)
+	c.Len(accounts, 1)
+	c.Equal([]*types.Coin{
+		{Denomination: "uwpokt", Amount: big.NewInt(21)},
+		{Denomination: "upokt", Amount: big.NewInt(212121)},
+	}, accounts[0].Balances)
+
+	rows = sqlmock.NewRows([]string{"id", "address", "height", "balance", "balance_denomination"}).
+		AddRow(1, "00353abd21ef72725b295ba5a9a5eb6082548e21", 21, "212121", "upokt")
+
+	mock.ExpectQuery("^SELECT \\* FROM accounts WHERE height = \\$1 AND balance_denomination = \\$2 AND balance >= \\$3::numeric "+
+		"ORDER BY balance DESC, address LIMIT \\$4 OFFSET \\$5$").
+		WithArgs(21, "upokt", "1000", 10, 0).WillReturnRows(rows)
+
+	accounts, err = driver.ReadAccounts(&types.ReadAccountsOptions{
+		PerPage:    10,
+		Height:     21,
+		SortBy:     types.AccountSortByBalance,
+		MinBalance: big.NewInt(1000),
+	})
+	c.NoError(err)
+	c.Len(accounts, 1)
+
+	mock.ExpectQuery("^SELECT \\* FROM accounts WHERE height = \\(SELECT MAX(.+) AND balance_denomination = \\$1 "+
+		"ORDER BY balance DESC, address LIMIT \\$2 OFFSET \\$3$").
+		WithArgs("upokt", 1000, 0).WillReturnError(errors.New("dummy error"))
+
+	accounts, err = driver.ReadAccounts(&types.ReadAccountsOptions{SortBy: types.AccountSortByBalance, Denomination: "upokt"})
+	c.EqualError(err, "dummy error")
+	c.Empty(accounts)
+
+	accounts, err = driver.ReadAccounts(&types.ReadAccountsOptions{SortBy: "address; DROP TABLE accounts"})
+	c.ErrorIs(err, ErrInvalidSortField)
+	c.Empty(accounts)
+
+	accounts, err = driver.ReadAccounts(&types.ReadAccountsOptions{SortBy: types.AccountSortByBalance, Order: "random"})
+	c.ErrorIs(err, ErrInvalidOrder)
+	c.Empty(accounts)
+
+	accounts, err = driver.ReadAccounts(&types.ReadAccountsOptions{Order: "random"})
+	c.ErrorIs(err, ErrInvalidOrder)
+	c.Empty(accounts)
+
+	c.NoError(mock.ExpectationsWereMet())
 }
 
 func TestPostgresDriver_GetAccountsQuantity(t *testing.T) {
@@ -0,0 +1,118 @@
+package postgresdriver
+
+import (
+	"database/sql"
+	"math/big"
+
+	"github.com/pokt-foundation/pocket-go/utils"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	// selectAddressSummaryScript reads the last account, node and app saved at or before the height
+	// and the transactions of the address up to the height
+	// the upokt balance is read from balances on accounts saved with another coin on the balance column
+	selectAddressSummaryScript = `
+	WITH target AS (
+		SELECT COALESCE(NULLIF($2::int, 0), (SELECT MAX(height) FROM blocks)) AS height
+	), account AS (
+		SELECT CASE WHEN balance_denomination = '` + types.NativeDenomination + `' THEN balance
+			ELSE COALESCE((SELECT SUM((coin->>'amount')::numeric) FROM jsonb_array_elements(balances) AS coin
+				WHERE coin->>'denom' = '` + types.NativeDenomination + `'), 0) END AS balance
+		FROM accounts
+		WHERE address = $1 AND height = (SELECT MAX(height) FROM accounts WHERE height <= (SELECT height FROM target))
+	), node AS (
+		SELECT tokens, jailed FROM nodes
+		WHERE address = $1 AND height = (SELECT MAX(height) FROM nodes WHERE height <= (SELECT height FROM target))
+	), app AS (
+		SELECT staked_tokens, jailed FROM apps
+		WHERE address = $1 AND height = (SELECT MAX(height) FROM apps WHERE height <= (SELECT height FROM target))
+	), txs AS (
+		SELECT MIN(height) AS first_seen_height, MAX(height) AS last_seen_height,
+			COUNT(*) FILTER (WHERE from_address = $1) AS sent_quantity,
+			COUNT(*) FILTER (WHERE to_address = $1) AS received_quantity,
+			COALESCE(SUM(amount) FILTER (WHERE from_address = $1 OR to_address = $1), 0) AS volume
+		FROM transactions
+		WHERE (from_address = $1 OR to_address = $1 OR signer_address = $1) AND height <= (SELECT height FROM target)
+	)
+	SELECT (SELECT height FROM target) AS height, account.balance,
+		node.tokens AS node_tokens, node.jailed AS node_jailed, app.staked_tokens AS app_staked_tokens, app.jailed AS app_jailed,
+		txs.first_seen_height, txs.last_seen_height, txs.sent_quantity, txs.received_quantity, txs.volume
+	FROM txs
+	LEFT JOIN account ON true
+	LEFT JOIN node ON true
+	LEFT JOIN app ON true`
+)
+
+// dbAddressSummary is struct handler for the address summary with types needed for Postgres processing
+type dbAddressSummary struct {
+	Height           sql.NullInt64  `db:"height"`
+	Balance          sql.NullString `db:"balance"`
+	NodeTokens       sql.NullString `db:"node_tokens"`
+	NodeJailed       sql.NullBool   `db:"node_jailed"`
+	AppStakedTokens  sql.NullString `db:"app_staked_tokens"`
+	AppJailed        sql.NullBool   `db:"app_jailed"`
+	FirstSeenHeight  sql.NullInt64  `db:"first_seen_height"`
+	LastSeenHeight   sql.NullInt64  `db:"last_seen_height"`
+	SentQuantity     int64          `db:"sent_quantity"`
+	ReceivedQuantity int64          `db:"received_quantity"`
+	Volume           string         `db:"volume"`
+}
+
+// toBigInt returns the number of a nullable numeric column, null is 0
+func toBigInt(value sql.NullString) *big.Int {
+	number := new(big.Int)
+
+	if value.Valid {
+		number, _ = number.SetString(value.String, 10)
+	}
+
+	return number
+}
+
+func (s *dbAddressSummary) toIndexerAddressSummary(address string) *types.AddressSummary {
+	volume := new(big.Int)
+	volume, _ = volume.SetString(s.Volume, 10)
+
+	return &types.AddressSummary{
+		Address:             address,
+		Height:              int(s.Height.Int64),
+		Balance:             toBigInt(s.Balance),
+		BalanceDenomination: types.NativeDenomination,
+		IsNode:              s.NodeTokens.Valid,
+		NodeTokens:          toBigInt(s.NodeTokens),
+		NodeJailed:          s.NodeJailed.Bool,
+		IsApp:               s.AppStakedTokens.Valid,
+		AppStakedTokens:     toBigInt(s.AppStakedTokens),
+		AppJailed:           s.AppJailed.Bool,
+		FirstSeenHeight:     int(s.FirstSeenHeight.Int64),
+		LastSeenHeight:      int(s.LastSeenHeight.Int64),
+		SentQuantity:        s.SentQuantity,
+		ReceivedQuantity:    s.ReceivedQuantity,
+		Volume:              volume,
+	}
+}
+
+// ReadAddressSummary returns the balance, node and app state and transactions activity of given address in one query
+// transactions signed by the address count for the first and last seen heights
+// Optional values defaults: height: last height
+func (d *PostgresDriver) ReadAddressSummary(address string, options *types.ReadAddressSummaryOptions) (*types.AddressSummary, error) {
+	if !utils.ValidateAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	var height int
+
+	if options != nil {
+		height = options.Height
+	}
+
+	var summary dbAddressSummary
+
+	err := d.reader().Get(&summary, selectAddressSummaryScript, address, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return summary.toIndexerAddressSummary(address), nil
+}
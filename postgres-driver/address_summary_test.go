@@ -0,0 +1,78 @@
+package postgresdriver
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_ReadAddressSummary(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	columns := []string{"height", "balance", "node_tokens", "node_jailed", "app_staked_tokens",
+		"app_jailed", "first_seen_height", "last_seen_height", "sent_quantity", "received_quantity", "volume"}
+
+	rows := sqlmock.NewRows(columns).
+		AddRow(21, "212121", "15000", true, nil, nil, 3, 20, 7, 2, "900")
+
+	mock.ExpectQuery("^WITH target AS (.+)SELECT CASE WHEN balance_denomination = 'upokt' THEN balance(.+)"+
+		"WHERE coin->>'denom' = 'upokt'\\), 0\\) END AS balance(.+) FROM txs").
+		WithArgs("00353abd21ef72725b295ba5a9a5eb6082548e21", 21).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	summary, err := driver.ReadAddressSummary("00353abd21ef72725b295ba5a9a5eb6082548e21", &types.ReadAddressSummaryOptions{Height: 21})
+	c.NoError(err)
+	c.Equal(&types.AddressSummary{
+		Address:             "00353abd21ef72725b295ba5a9a5eb6082548e21",
+		Height:              21,
+		Balance:             big.NewInt(212121),
+		BalanceDenomination: "upokt",
+		IsNode:              true,
+		NodeTokens:          big.NewInt(15000),
+		NodeJailed:          true,
+		AppStakedTokens:     new(big.Int),
+		FirstSeenHeight:     3,
+		LastSeenHeight:      20,
+		SentQuantity:        7,
+		ReceivedQuantity:    2,
+		Volume:              big.NewInt(900),
+	}, summary)
+
+	rows = sqlmock.NewRows(columns).
+		AddRow(30, nil, nil, nil, "212121", false, nil, nil, 0, 0, "0")
+
+	mock.ExpectQuery("^WITH target AS (.+) FROM txs").
+		WithArgs("00353abd21ef72725b295ba5a9a5eb6082548e21", 0).WillReturnRows(rows)
+
+	summary, err = driver.ReadAddressSummary("00353abd21ef72725b295ba5a9a5eb6082548e21", nil)
+	c.NoError(err)
+	c.Equal(30, summary.Height)
+	c.False(summary.IsNode)
+	c.True(summary.IsApp)
+	c.Equal(big.NewInt(212121), summary.AppStakedTokens)
+	c.Zero(summary.Balance.Int64())
+	c.Equal("upokt", summary.BalanceDenomination)
+	c.Zero(summary.FirstSeenHeight)
+
+	summary, err = driver.ReadAddressSummary("00353abd21ef72725b295ba5a9a5eb6082548e2", nil)
+	c.Equal(ErrInvalidAddress, err)
+	c.Empty(summary)
+
+	mock.ExpectQuery("^WITH target AS (.+) FROM txs").WillReturnError(errors.New("dummy error"))
+
+	summary, err = driver.ReadAddressSummary("00353abd21ef72725b295ba5a9a5eb6082548e21", nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(summary)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
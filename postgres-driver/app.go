@@ -1,8 +1,12 @@
 package postgresdriver
 
 import (
+	"database/sql"
+	"fmt"
 	"math/big"
+	"strings"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"github.com/pokt-foundation/pocket-go/utils"
 	"github.com/pokt-foundation/pocket-indexer-lib/types"
@@ -10,26 +14,22 @@ import (
 
 const (
 	insertAppsScript = `
-	INSERT into apps (address, height, jailed, public_key, staked_tokens)
+	INSERT into apps (address, height, jailed, public_key, staked_tokens, chains, max_relays, unstaking_time)
 	(
-		select * from unnest($1::text[], $2::int[], $3::boolean[], $4::text[], $5::numeric[])
+		select address, height, jailed, public_key, staked_tokens, string_to_array(NULLIF(chains, ''), ','), max_relays, unstaking_time
+		from unnest($1::text[], $2::int[], $3::boolean[], $4::text[], $5::numeric[], $6::text[], $7::numeric[], $8::timestamptz[])
+		as t(address, height, jailed, public_key, staked_tokens, chains, max_relays, unstaking_time)
 	)`
-	selectAppsScript = `
-	DECLARE apps_cursor CURSOR FOR SELECT * FROM apps WHERE height = (SELECT MAX(height) FROM apps);
-	MOVE absolute %d from apps_cursor;
-	FETCH %d FROM apps_cursor;
-	`
-	selectAppsByHeightScript = `
-	DECLARE apps_cursor CURSOR FOR SELECT * FROM apps WHERE height = '%d';
-	MOVE absolute %d from apps_cursor;
-	FETCH %d FROM apps_cursor;
-	`
 	selectAppByAddressScript          = "SELECT * FROM apps WHERE address = $1 AND height = (SELECT MAX(height) FROM apps)"
 	selectAppByAddressAndHeightScript = "SELECT * FROM apps WHERE address = $1 AND height = $2"
 	selectCountFromApps               = "SELECT COUNT(*) FROM apps WHERE height = (SELECT MAX(height) FROM apps)"
 	selectCountFromAppsByHeight       = "SELECT COUNT(*) FROM apps WHERE height = $1"
 )
 
+var appSortColumns = map[types.AppSortField]string{
+	types.AppSortByStakedTokens: "staked_tokens",
+}
+
 // dbApp is struct handler for the app with types needed for Postgres processing
 type dbApp struct {
 	ID           int    `db:"id"`
@@ -38,34 +38,67 @@ type dbApp struct {
 	Jailed       bool   `db:"jailed"`
 	PublicKey    string `db:"public_key"`
 	StakedTokens string `db:"staked_tokens"`
+	// Chains are sent as a joined string to be able to use SQL's Unnest with this field
+	// and stored as an array by the insert
+	Chains        pq.StringArray `db:"chains"`
+	MaxRelays     sql.NullString `db:"max_relays"`
+	UnstakingTime sql.NullTime   `db:"unstaking_time"`
 }
 
 func (a *dbApp) toIndexerApp() *types.App {
 	stakedTokens := new(big.Int)
 	stakedTokens, _ = stakedTokens.SetString(a.StakedTokens, 10)
 
+	var maxRelays *big.Int
+
+	if a.MaxRelays.Valid {
+		maxRelays, _ = new(big.Int).SetString(a.MaxRelays.String, 10)
+	}
+
 	return &types.App{
-		Address:      a.Address,
-		Height:       a.Height,
-		Jailed:       a.Jailed,
-		PublicKey:    a.PublicKey,
-		StakedTokens: stakedTokens,
+		Address:       a.Address,
+		Height:        a.Height,
+		Jailed:        a.Jailed,
+		PublicKey:     a.PublicKey,
+		StakedTokens:  stakedTokens,
+		Chains:        a.Chains,
+		MaxRelays:     maxRelays,
+		UnstakingTime: a.UnstakingTime.Time,
 	}
 }
 
 func convertIndexerAppToDBApp(indexerApp *types.App) *dbApp {
+	var maxRelays sql.NullString
+
+	if indexerApp.MaxRelays != nil {
+		maxRelays = newSQLNullString(indexerApp.MaxRelays.String())
+	}
+
 	return &dbApp{
-		Address:      indexerApp.Address,
-		Height:       indexerApp.Height,
-		Jailed:       indexerApp.Jailed,
-		PublicKey:    indexerApp.PublicKey,
-		StakedTokens: indexerApp.StakedTokens.String(),
+		Address:       indexerApp.Address,
+		Height:        indexerApp.Height,
+		Jailed:        indexerApp.Jailed,
+		PublicKey:     indexerApp.PublicKey,
+		StakedTokens:  indexerApp.StakedTokens.String(),
+		Chains:        indexerApp.Chains,
+		MaxRelays:     maxRelays,
+		UnstakingTime: newSQLNullTime(indexerApp.UnstakingTime),
 	}
 }
 
 // WriteApps inserts given apps to the database
 func (d *PostgresDriver) WriteApps(apps []*types.App) error {
-	var addresses, publicKeys, allStakedTokens []string
+	return writeApps(d, apps)
+}
+
+// writeApps inserts nothing when there are no apps
+func writeApps(db sqlx.Execer, apps []*types.App) error {
+	if len(apps) == 0 {
+		return nil
+	}
+
+	var addresses, publicKeys, allStakedTokens, allChains []string
+	var allMaxRelays, unstakingTimes []sql.NullString
 	var heights []int64
 	var jaileds []bool
 
@@ -76,13 +109,19 @@ func (d *PostgresDriver) WriteApps(apps []*types.App) error {
 		jaileds = append(jaileds, dbApp.Jailed)
 		publicKeys = append(publicKeys, dbApp.PublicKey)
 		allStakedTokens = append(allStakedTokens, dbApp.StakedTokens)
+		allChains = append(allChains, strings.Join(dbApp.Chains, chainsSeparator))
+		allMaxRelays = append(allMaxRelays, dbApp.MaxRelays)
+		unstakingTimes = append(unstakingTimes, formatSQLNullTime(dbApp.UnstakingTime))
 	}
 
-	_, err := d.Exec(insertAppsScript, pq.StringArray(addresses),
+	_, err := db.Exec(insertAppsScript, pq.StringArray(addresses),
 		pq.Int64Array(heights),
 		pq.BoolArray(jaileds),
 		pq.StringArray(publicKeys),
-		pq.StringArray(allStakedTokens))
+		pq.StringArray(allStakedTokens),
+		pq.StringArray(allChains),
+		pq.Array(allMaxRelays),
+		pq.Array(unstakingTimes))
 	if err != nil {
 		return err
 	}
@@ -104,12 +143,12 @@ func (d *PostgresDriver) ReadAppByAddress(address string, options *types.ReadApp
 	}
 
 	if height == 0 {
-		err := d.Get(&dbApp, selectAppByAddressScript, address)
+		err := d.reader().Get(&dbApp, selectAppByAddressScript, address)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		err := d.Get(&dbApp, selectAppByAddressAndHeightScript, address, height)
+		err := d.reader().Get(&dbApp, selectAppByAddressAndHeightScript, address, height)
 		if err != nil {
 			return nil, err
 		}
@@ -118,37 +157,53 @@ func (d *PostgresDriver) ReadAppByAddress(address string, options *types.ReadApp
 	return dbApp.toIndexerApp(), nil
 }
 
-// ReadApps returns apps with given height
-// Optional values defaults: page: 1, perPage: 1000
-func (d *PostgresDriver) ReadApps(options *types.ReadAppsOptions) ([]*types.App, error) {
-	perPage := defaultPerPage
-	page := defaultPage
-	height := 0
+func getAppsPageQuery(options *types.ReadAppsOptions) (string, []any, error) {
+	sortColumn, ok := appSortColumns[options.SortBy]
+	if !ok && options.SortBy != "" {
+		return "", nil, fmt.Errorf("%w: %q", ErrInvalidSortField, options.SortBy)
+	}
 
-	if options != nil {
-		perPage = getPerPageValue(options.PerPage)
-		page = getPageValue(options.Page)
-		height = options.Height
+	sort, err := getSortValue(sortColumn, options.Order)
+	if err != nil {
+		return "", nil, err
 	}
 
-	move := getMoveValue(perPage, page)
+	filters := &filterQuery{}
+	filters.addHeightFilter("apps", options.Height)
 
-	tx, err := d.Beginx()
-	if err != nil {
-		return nil, err
+	if options.Jailed != nil {
+		filters.addFilter("jailed = %s", *options.Jailed)
 	}
 
-	query := getHeightOptionalQuery(selectAppsByHeightScript, selectAppsScript,
-		height, move, perPage)
+	if options.MinStakedTokens != nil {
+		filters.addFilter("staked_tokens >= %s::numeric", options.MinStakedTokens.String())
+	}
 
-	var apps []*dbApp
+	if options.Chain != "" {
+		filters.addFilter("chains @> ARRAY[%s]", options.Chain)
+	}
+
+	perPage := getPerPageValue(options.PerPage)
+	query, args := buildPageQuery("apps", filters, sort, perPage, getMoveValue(perPage, getPageValue(options.Page)))
 
-	err = tx.Select(&apps, query)
+	return query, args, nil
+}
+
+// ReadApps returns apps with given height
+// Optional values defaults: page: 1, perPage: 1000, height: last height, order: desc when sorted
+func (d *PostgresDriver) ReadApps(options *types.ReadAppsOptions) ([]*types.App, error) {
+	if options == nil {
+		options = &types.ReadAppsOptions{}
+	}
+
+	query, args, err := getAppsPageQuery(options)
 	if err != nil {
 		return nil, err
 	}
 
-	err = tx.Commit()
+	var apps []*dbApp
+
+	err = d.reader().Select(&apps, query, args...)
 	if err != nil {
 		return nil, err
 	}
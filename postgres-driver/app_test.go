@@ -1,9 +1,11 @@
 package postgresdriver
 
 import (
+	"database/sql"
 	"errors"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/lib/pq"
@@ -21,18 +23,22 @@ func TestPostgresDriver_WriteApps(t *testing.T) {
 
 	mock.ExpectExec("INSERT into apps").WithArgs(pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}), pq.Int64Array([]int64{21}),
 		pq.BoolArray([]bool{false}), pq.StringArray([]string{"01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903"}),
-		pq.StringArray([]string{"212121"})).
+		pq.StringArray([]string{"212121"}), pq.StringArray([]string{"0001,0021"}),
+		pq.Array([]sql.NullString{{String: "1000", Valid: true}}), pq.Array([]sql.NullString{{String: "1999-07-21T00:00:00Z", Valid: true}})).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	driver := NewPostgresDriverFromSQLDBInstance(db)
 
 	appsToSend := []*types.App{
 		{
-			Address:      "00353abd21ef72725b295ba5a9a5eb6082548e21",
-			Height:       21,
-			Jailed:       false,
-			PublicKey:    "01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903",
-			StakedTokens: big.NewInt(212121),
+			Address:       "00353abd21ef72725b295ba5a9a5eb6082548e21",
+			Height:        21,
+			Jailed:        false,
+			PublicKey:     "01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903",
+			StakedTokens:  big.NewInt(212121),
+			Chains:        []string{"0001", "0021"},
+			MaxRelays:     big.NewInt(1000),
+			UnstakingTime: time.Date(1999, time.July, 21, 0, 0, 0, 0, time.UTC),
 		},
 	}
 
@@ -41,7 +47,8 @@ func TestPostgresDriver_WriteApps(t *testing.T) {
 
 	mock.ExpectExec("INSERT into apps").WithArgs(pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}), pq.Int64Array([]int64{21}),
 		pq.BoolArray([]bool{false}), pq.StringArray([]string{"01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903"}),
-		pq.StringArray([]string{"212121"})).
+		pq.StringArray([]string{"212121"}), pq.StringArray([]string{"0001,0021"}),
+		pq.Array([]sql.NullString{{String: "1000", Valid: true}}), pq.Array([]sql.NullString{{String: "1999-07-21T00:00:00Z", Valid: true}})).
 		WillReturnError(errors.New("dummy error"))
 
 	err = driver.WriteApps(appsToSend)
@@ -109,9 +116,7 @@ func TestPostgresDriver_ReadApps(t *testing.T) {
 		AddRow(1, "00353abd21ef72725b295ba5a9a5eb6082548e22", 21, false,
 			"01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903", "212121")
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnRows(rows)
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM apps WHERE height = \\$1").WithArgs(21, 7, 140).WillReturnRows(rows)
 
 	driver := NewPostgresDriverFromSQLDBInstance(db)
 
@@ -119,13 +124,49 @@ func TestPostgresDriver_ReadApps(t *testing.T) {
 	c.NoError(err)
 	c.Len(apps, 2)
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnError(errors.New("dummy error"))
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM apps WHERE height = \\(SELECT MAX").WithArgs(1000, 0).WillReturnError(errors.New("dummy error"))
 
 	apps, err = driver.ReadApps(&types.ReadAppsOptions{})
 	c.EqualError(err, "dummy error")
 	c.Empty(apps)
+
+	rows = sqlmock.NewRows([]string{"id", "address", "height", "jailed", "public_key", "staked_tokens"}).
+		AddRow(1, "00353abd21ef72725b295ba5a9a5eb6082548e21", 21, true,
+			"01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903", "212121")
+
+	mock.ExpectQuery("^SELECT \\* FROM apps WHERE height = \\$1 AND jailed = \\$2 ORDER BY staked_tokens DESC, address LIMIT \\$3 OFFSET \\$4$").
+		WithArgs(21, true, 5, 0).WillReturnRows(rows)
+
+	jailed := true
+
+	apps, err = driver.ReadApps(&types.ReadAppsOptions{
+		PerPage: 5,
+		Height:  21,
+		SortBy:  types.AppSortByStakedTokens,
+		Jailed:  &jailed,
+	})
+	c.NoError(err)
+	c.Len(apps, 1)
+
+	rows = sqlmock.NewRows([]string{"id", "address", "height", "jailed", "public_key", "staked_tokens", "chains", "max_relays", "unstaking_time"}).
+		AddRow(1, "00353abd21ef72725b295ba5a9a5eb6082548e21", 21, false,
+			"01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903", "212121", "{0001,0021}", "1000", nil)
+
+	mock.ExpectQuery("^SELECT \\* FROM apps WHERE height = \\(SELECT MAX\\(height\\) FROM apps\\) AND chains @> ARRAY\\[\\$1\\] LIMIT \\$2 OFFSET \\$3$").
+		WithArgs("0021", 1000, 0).WillReturnRows(rows)
+
+	apps, err = driver.ReadApps(&types.ReadAppsOptions{Chain: "0021"})
+	c.NoError(err)
+	c.Len(apps, 1)
+	c.Equal([]string{"0001", "0021"}, apps[0].Chains)
+	c.Equal(big.NewInt(1000), apps[0].MaxRelays)
+	c.True(apps[0].UnstakingTime.IsZero())
+
+	apps, err = driver.ReadApps(&types.ReadAppsOptions{SortBy: "public_key"})
+	c.ErrorIs(err, ErrInvalidSortField)
+	c.Empty(apps)
+
+	c.NoError(mock.ExpectationsWereMet())
 }
 
 func TestPostgresDriver_GetAppsQuantity(t *testing.T) {
@@ -2,10 +2,14 @@ package postgresdriver
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/jmoiron/sqlx"
+	"github.com/pokt-foundation/pocket-go/utils"
 	"github.com/pokt-foundation/pocket-indexer-lib/types"
 )
 
@@ -17,11 +21,14 @@ const (
 	UPDATE blocks
 	SET accounts_quantity = :accounts_quantity, apps_quantity = :apps_quantity, nodes_quantity = :nodes_quantity, took = :took
 	WHERE height = :height`
-	selectBlocksScript = `
-	DECLARE blocks_cursor CURSOR FOR SELECT * FROM blocks ORDER BY height %s;
-	MOVE absolute %d from blocks_cursor;
-	FETCH %d FROM blocks_cursor;
-	`
+	selectBlockBeforeTimeScript  = "SELECT * FROM blocks WHERE time <= $1 ORDER BY time DESC LIMIT 1"
+	selectBlockAfterTimeScript   = "SELECT * FROM blocks WHERE time >= $1 ORDER BY time ASC LIMIT 1"
+	selectBlockNearestTimeScript = `
+	SELECT * FROM (
+		(SELECT * FROM blocks WHERE time <= $1 ORDER BY time DESC LIMIT 1)
+		UNION ALL
+		(SELECT * FROM blocks WHERE time >= $1 ORDER BY time ASC LIMIT 1)
+	) AS candidates ORDER BY ABS(EXTRACT(EPOCH FROM (time - $1))) LIMIT 1`
 	selectBlockByHashScript      = "SELECT * FROM blocks WHERE hash = $1"
 	selectBlockByHeightScript    = "SELECT * FROM blocks WHERE height = $1"
 	selectBlockByMaxHeightScript = "SELECT * FROM blocks WHERE height = (SELECT MAX(height) FROM blocks)"
@@ -29,6 +36,17 @@ const (
 	selectMaxHeightFromBlocks    = "SELECT MAX(height) FROM blocks"
 )
 
+var (
+	// ErrInvalidTimeLookup error when given time lookup is not supported
+	ErrInvalidTimeLookup = errors.New("invalid time lookup")
+
+	timeLookupScripts = map[types.TimeLookup]string{
+		types.BeforeTimeLookup:  selectBlockBeforeTimeScript,
+		types.AfterTimeLookup:   selectBlockAfterTimeScript,
+		types.NearestTimeLookup: selectBlockNearestTimeScript,
+	}
+)
+
 // dbBlock is struct handler for the block with types needed for Postgres processing
 type dbBlock struct {
 	ID               int       `db:"id"`
@@ -74,9 +92,13 @@ func convertIndexerBlockToDBBlock(indexerBlock *types.Block) *dbBlock {
 
 // WriteBlock inserts given block to the database
 func (d *PostgresDriver) WriteBlock(block *types.Block) error {
+	return writeBlock(d, block)
+}
+
+func writeBlock(db sqlx.Ext, block *types.Block) error {
 	dbBlock := convertIndexerBlockToDBBlock(block)
 
-	_, err := d.NamedExec(insertBlockScript, dbBlock)
+	_, err := sqlx.NamedExec(db, insertBlockScript, dbBlock)
 	if err != nil {
 		return err
 	}
@@ -115,35 +137,48 @@ func (d *PostgresDriver) WriteBlockCalculatedFields(block *types.Block) error {
 }
 
 // ReadBlocks returns all blocks on the database with pagination
-// Optional values defaults: page: 1, perPage: 1000
+// Optional values defaults: page: 1, perPage: 1000, order: desc, time range: all
 func (d *PostgresDriver) ReadBlocks(options *types.ReadBlocksOptions) ([]*types.Block, error) {
-	perPage := defaultPerPage
-	page := defaultPage
-	order := defaultOrder
-
-	if options != nil {
-		perPage = getPerPageValue(options.PerPage)
-		page = getPageValue(options.Page)
-		order = getOrderValue(options.Order)
+	if options == nil {
+		options = &types.ReadBlocksOptions{}
 	}
 
-	move := getMoveValue(perPage, page)
+	filters := getBlockRangeFilters(0, 0, options.StartTime, options.EndTime)
 
-	tx, err := d.Beginx()
-	if err != nil {
-		return nil, err
+	return d.readBlocksPage(filters, options.Order, options.PerPage, options.Page)
+}
+
+// ReadBlocksByProposer returns blocks proposed by given address with pagination
+// Optional values defaults: page: 1, perPage: 1000, order: desc
+func (d *PostgresDriver) ReadBlocksByProposer(address string, options *types.ReadBlocksByProposerOptions) ([]*types.Block, error) {
+	if !utils.ValidateAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	if options == nil {
+		options = &types.ReadBlocksByProposerOptions{}
 	}
 
-	query := fmt.Sprintf(selectBlocksScript, order, move, perPage)
+	filters := getBlockRangeFilters(options.FromHeight, options.ToHeight, time.Time{}, time.Time{})
+	// proposer addresses are stored uppercase
+	filters.addFilter("proposer_address = %s", strings.ToUpper(address))
 
-	var blocks []*dbBlock
+	return d.readBlocksPage(filters, options.Order, options.PerPage, options.Page)
+}
 
-	err = tx.Select(&blocks, query)
+func (d *PostgresDriver) readBlocksPage(filters *filterQuery, optionsOrder types.Order, optionsPerPage, optionsPage int) ([]*types.Block, error) {
+	order, err := getOrderValue(optionsOrder)
 	if err != nil {
 		return nil, err
 	}
 
-	err = tx.Commit()
+	perPage := getPerPageValue(optionsPerPage)
+	query, args := buildPageQuery("blocks", filters, fmt.Sprintf("ORDER BY height %s", order),
+		perPage, getMoveValue(perPage, getPageValue(optionsPage)))
+
+	var blocks []*dbBlock
+
+	err = d.reader().Select(&blocks, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +196,7 @@ func (d *PostgresDriver) ReadBlocks(options *types.ReadBlocksOptions) ([]*types.
 func (d *PostgresDriver) ReadBlockByHash(hash string) (*types.Block, error) {
 	var dbBlock dbBlock
 
-	err := d.Get(&dbBlock, selectBlockByHashScript, hash)
+	err := d.reader().Get(&dbBlock, selectBlockByHashScript, hash)
 	if err != nil {
 		return nil, err
 	}
@@ -175,12 +210,12 @@ func (d *PostgresDriver) ReadBlockByHeight(height int) (*types.Block, error) {
 	var dbBlock dbBlock
 
 	if height == 0 {
-		err := d.Get(&dbBlock, selectBlockByMaxHeightScript)
+		err := d.reader().Get(&dbBlock, selectBlockByMaxHeightScript)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		err := d.Get(&dbBlock, selectBlockByHeightScript, height)
+		err := d.reader().Get(&dbBlock, selectBlockByHeightScript, height)
 		if err != nil {
 			return nil, err
 		}
@@ -189,9 +224,31 @@ func (d *PostgresDriver) ReadBlockByHeight(height int) (*types.Block, error) {
 	return dbBlock.toIndexerBlock(), nil
 }
 
+// ReadBlockByTime returns the block matched to given time with the given lookup
+// Optional values defaults: lookup: before
+func (d *PostgresDriver) ReadBlockByTime(blockTime time.Time, lookup types.TimeLookup) (*types.Block, error) {
+	if lookup == "" {
+		lookup = types.BeforeTimeLookup
+	}
+
+	query, ok := timeLookupScripts[lookup]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimeLookup, lookup)
+	}
+
+	var dbBlock dbBlock
+
+	err := d.reader().Get(&dbBlock, query, blockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbBlock.toIndexerBlock(), nil
+}
+
 // GetMaxHeightInBlocks returns max height saved on blocks' table
 func (d *PostgresDriver) GetMaxHeightInBlocks() (int64, error) {
-	row := d.QueryRow(selectMaxHeightFromBlocks)
+	row := d.reader().QueryRow(selectMaxHeightFromBlocks)
 
 	var maxHeight sql.NullInt64
 
@@ -209,7 +266,7 @@ func (d *PostgresDriver) GetMaxHeightInBlocks() (int64, error) {
 
 // GetBlocksQuantity returns quantity of blocks saved
 func (d *PostgresDriver) GetBlocksQuantity() (int64, error) {
-	row := d.QueryRow(selectCountFromBlocks)
+	row := d.reader().QueryRow(selectCountFromBlocks)
 
 	var quantity int64
 
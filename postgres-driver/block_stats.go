@@ -0,0 +1,161 @@
+package postgresdriver
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	selectBlockTimeStatsScript = `
+	SELECT %s
+		COUNT(*) AS blocks_quantity,
+		MIN(height) AS start_height,
+		MAX(height) AS end_height,
+		MIN(time) AS start_time,
+		MAX(time) AS end_time,
+		COALESCE(AVG(took), 0)::bigint AS average_took,
+		COALESCE(MIN(took), 0) AS min_took,
+		COALESCE(MAX(took), 0) AS max_took,
+		COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY took), 0)::bigint AS median_took,
+		COALESCE(PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY took), 0)::bigint AS p90_took,
+		COALESCE(PERCENTILE_CONT(0.99) WITHIN GROUP (ORDER BY took), 0)::bigint AS p99_took,
+		COALESCE(SUM(tx_count), 0) AS tx_quantity,
+		COALESCE(AVG(tx_count), 0) AS average_tx_per_block,
+		COALESCE(SUM(tx_count) / NULLIF(SUM(took) / 1e9, 0), 0) AS tx_per_second
+	FROM (
+		SELECT height, time, tx_count, NULLIF(NULLIF(took, ''), '0')::bigint AS took FROM blocks %s
+	) AS ranged_blocks
+	%s`
+)
+
+var (
+	// ErrInvalidStatsInterval error when given stats interval is not supported
+	ErrInvalidStatsInterval = errors.New("invalid stats interval")
+
+	// statsIntervalFields are the date_trunc fields of each stats interval
+	statsIntervalFields = map[types.StatsInterval]string{
+		types.HourlyStatsInterval: "hour",
+		types.DailyStatsInterval:  "day",
+	}
+)
+
+// dbBlockTimeStats is struct handler for the block time stats with types needed for Postgres processing
+type dbBlockTimeStats struct {
+	BucketStart       sql.NullTime  `db:"bucket_start"`
+	BlocksQuantity    int           `db:"blocks_quantity"`
+	StartHeight       sql.NullInt64 `db:"start_height"`
+	EndHeight         sql.NullInt64 `db:"end_height"`
+	StartTime         sql.NullTime  `db:"start_time"`
+	EndTime           sql.NullTime  `db:"end_time"`
+	AverageTook       int64         `db:"average_took"`
+	MinTook           int64         `db:"min_took"`
+	MaxTook           int64         `db:"max_took"`
+	MedianTook        int64         `db:"median_took"`
+	P90Took           int64         `db:"p90_took"`
+	P99Took           int64         `db:"p99_took"`
+	TXQuantity        int           `db:"tx_quantity"`
+	AverageTXPerBlock float64       `db:"average_tx_per_block"`
+	TXPerSecond       float64       `db:"tx_per_second"`
+}
+
+func (s *dbBlockTimeStats) toIndexerBlockTimeStats() *types.BlockTimeStats {
+	return &types.BlockTimeStats{
+		BucketStart:                 s.BucketStart.Time,
+		StartHeight:                 int(s.StartHeight.Int64),
+		EndHeight:                   int(s.EndHeight.Int64),
+		StartTime:                   s.StartTime.Time,
+		EndTime:                     s.EndTime.Time,
+		BlocksQuantity:              s.BlocksQuantity,
+		AverageBlockTime:            time.Duration(s.AverageTook),
+		MinBlockTime:                time.Duration(s.MinTook),
+		MaxBlockTime:                time.Duration(s.MaxTook),
+		MedianBlockTime:             time.Duration(s.MedianTook),
+		P90BlockTime:                time.Duration(s.P90Took),
+		P99BlockTime:                time.Duration(s.P99Took),
+		TransactionsQuantity:        s.TXQuantity,
+		AverageTransactionsPerBlock: s.AverageTXPerBlock,
+		TransactionsPerSecond:       s.TXPerSecond,
+	}
+}
+
+// getBlockRangeFilters returns the filters of blocks in the given range
+// heights are inclusive, startTime is inclusive and endTime exclusive, zero values leave the range open
+func getBlockRangeFilters(fromHeight, toHeight int, startTime, endTime time.Time) *filterQuery {
+	filters := &filterQuery{}
+
+	if fromHeight > 0 {
+		filters.addFilter("height >= %s", fromHeight)
+	}
+
+	if toHeight > 0 {
+		filters.addFilter("height <= %s", toHeight)
+	}
+
+	if !startTime.IsZero() {
+		filters.addFilter("time >= %s", startTime)
+	}
+
+	if !endTime.IsZero() {
+		filters.addFilter("time < %s", endTime)
+	}
+
+	return filters
+}
+
+// ReadBlockTimeStats returns block time and throughput stats of the blocks in the given range
+// Optional values defaults: range: all blocks
+func (d *PostgresDriver) ReadBlockTimeStats(options *types.ReadBlockTimeStatsOptions) (*types.BlockTimeStats, error) {
+	if options == nil {
+		options = &types.ReadBlockTimeStatsOptions{}
+	}
+
+	filters := getBlockRangeFilters(options.FromHeight, options.ToHeight, options.StartTime, options.EndTime)
+	query := fmt.Sprintf(selectBlockTimeStatsScript, "", filters.where(), "")
+
+	var stats dbBlockTimeStats
+
+	err := d.reader().Get(&stats, query, filters.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats.toIndexerBlockTimeStats(), nil
+}
+
+// ReadBlockTimeStatsBuckets returns block time and throughput stats of the blocks in the given range
+// grouped in buckets of the given interval, buckets without blocks are not returned
+// Optional values defaults: range: all blocks
+func (d *PostgresDriver) ReadBlockTimeStatsBuckets(interval types.StatsInterval, options *types.ReadBlockTimeStatsOptions) ([]*types.BlockTimeStats, error) {
+	intervalField, ok := statsIntervalFields[interval]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidStatsInterval, interval)
+	}
+
+	if options == nil {
+		options = &types.ReadBlockTimeStatsOptions{}
+	}
+
+	filters := getBlockRangeFilters(options.FromHeight, options.ToHeight, options.StartTime, options.EndTime)
+	bucket := fmt.Sprintf("date_trunc(%s, time)", filters.addArg(intervalField))
+	query := fmt.Sprintf(selectBlockTimeStatsScript, bucket+" AS bucket_start,", filters.where(),
+		"GROUP BY bucket_start ORDER BY bucket_start")
+
+	var allStats []*dbBlockTimeStats
+
+	err := d.reader().Select(&allStats, query, filters.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexerStats []*types.BlockTimeStats
+
+	for _, stats := range allStats {
+		indexerStats = append(indexerStats, stats.toIndexerBlockTimeStats())
+	}
+
+	return indexerStats, nil
+}
@@ -0,0 +1,104 @@
+package postgresdriver
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+var blockTimeStatsColumns = []string{"blocks_quantity", "start_height", "end_height", "start_time", "end_time",
+	"average_took", "min_took", "max_took", "median_took", "p90_took", "p99_took", "tx_quantity",
+	"average_tx_per_block", "tx_per_second"}
+
+func TestPostgresDriver_ReadBlockTimeStats(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	startTime := time.Date(1999, time.July, 21, 0, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows(blockTimeStatsColumns).
+		AddRow(10, 21, 30, startTime, startTime.Add(2*time.Hour), int64(15*time.Minute), int64(10*time.Minute),
+			int64(20*time.Minute), int64(15*time.Minute), int64(19*time.Minute), int64(20*time.Minute), 300, 30.0, 0.033)
+
+	mock.ExpectQuery("FROM blocks WHERE height >= \\$1 AND height <= \\$2").WithArgs(21, 30).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	stats, err := driver.ReadBlockTimeStats(&types.ReadBlockTimeStatsOptions{FromHeight: 21, ToHeight: 30})
+	c.NoError(err)
+	c.Equal(10, stats.BlocksQuantity)
+	c.Equal(21, stats.StartHeight)
+	c.Equal(30, stats.EndHeight)
+	c.Equal(15*time.Minute, stats.AverageBlockTime)
+	c.Equal(19*time.Minute, stats.P90BlockTime)
+	c.Equal(300, stats.TransactionsQuantity)
+
+	rows = sqlmock.NewRows(blockTimeStatsColumns).AddRow(0, nil, nil, nil, nil, 0, 0, 0, 0, 0, 0, 0, 0.0, 0.0)
+
+	mock.ExpectQuery("FROM blocks\\s+\\) AS ranged_blocks").WillReturnRows(rows)
+
+	stats, err = driver.ReadBlockTimeStats(nil)
+	c.NoError(err)
+	c.Empty(stats.BlocksQuantity)
+	c.Empty(stats.StartHeight)
+
+	mock.ExpectQuery("FROM blocks").WillReturnError(errors.New("dummy error"))
+
+	stats, err = driver.ReadBlockTimeStats(nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(stats)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadBlockTimeStatsBuckets(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	startTime := time.Date(1999, time.July, 21, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(1999, time.July, 23, 0, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows(append([]string{"bucket_start"}, blockTimeStatsColumns...)).
+		AddRow(startTime, 96, 21, 116, startTime, startTime.Add(23*time.Hour), int64(15*time.Minute), int64(10*time.Minute),
+			int64(20*time.Minute), int64(15*time.Minute), int64(19*time.Minute), int64(20*time.Minute), 300, 3.125, 0.0034).
+		AddRow(startTime.Add(24*time.Hour), 96, 117, 212, startTime.Add(24*time.Hour), startTime.Add(47*time.Hour), int64(15*time.Minute),
+			int64(10*time.Minute), int64(20*time.Minute), int64(15*time.Minute), int64(19*time.Minute), int64(20*time.Minute), 300, 3.125, 0.0034)
+
+	mock.ExpectQuery("SELECT date_trunc\\(\\$3, time\\) AS bucket_start,(.+)WHERE time >= \\$1 AND time < \\$2(.+)GROUP BY bucket_start ORDER BY bucket_start").
+		WithArgs(startTime, endTime, "day").WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	allStats, err := driver.ReadBlockTimeStatsBuckets(types.DailyStatsInterval, &types.ReadBlockTimeStatsOptions{
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	c.NoError(err)
+	c.Len(allStats, 2)
+	c.Equal(startTime.Add(24*time.Hour), allStats[1].BucketStart)
+	c.Equal(117, allStats[1].StartHeight)
+
+	mock.ExpectQuery("SELECT date_trunc").WithArgs("hour").WillReturnError(errors.New("dummy error"))
+
+	allStats, err = driver.ReadBlockTimeStatsBuckets(types.HourlyStatsInterval, nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(allStats)
+
+	allStats, err = driver.ReadBlockTimeStatsBuckets("weekly", nil)
+	c.ErrorIs(err, ErrInvalidStatsInterval)
+	c.Empty(allStats)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
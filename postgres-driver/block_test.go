@@ -97,9 +97,7 @@ func TestPostgresDriver_ReadBlocks(t *testing.T) {
 		AddRow(1, "ABCD", 21, time.Date(1999, time.July, 21, 0, 0, 0, 0, time.Local), "ABCD", 21, 100, 212121, 2121, 2323, "2121").
 		AddRow(1, "EDFG", 22, time.Date(1999, time.July, 21, 0, 0, 0, 0, time.Local), "ABCD", 21, 100, 212121, 2121, 2323, "2121")
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnRows(rows)
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM blocks ORDER BY height DESC").WithArgs(7, 0).WillReturnRows(rows)
 
 	driver := NewPostgresDriverFromSQLDBInstance(db)
 
@@ -108,13 +106,65 @@ func TestPostgresDriver_ReadBlocks(t *testing.T) {
 	c.Len(blocks, 2)
 	c.Equal(blocks[0].Hash, "ABCD")
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnError(errors.New("dummy error"))
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM blocks ORDER BY height DESC").WithArgs(1000, 0).WillReturnError(errors.New("dummy error"))
 
 	blocks, err = driver.ReadBlocks(&types.ReadBlocksOptions{})
 	c.EqualError(err, "dummy error")
 	c.Empty(blocks)
+
+	startTime := time.Date(1999, time.July, 21, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(1999, time.July, 22, 0, 0, 0, 0, time.UTC)
+
+	rows = sqlmock.NewRows([]string{"id", "hash", "height", "time", "proposer_address", "tx_count", "tx_total",
+		"accounts_quantity", "apps_quantity", "nodes_quantity", "took"}).
+		AddRow(1, "ABCD", 21, startTime, "ABCD", 21, 100, 212121, 2121, 2323, "2121")
+
+	mock.ExpectQuery("^SELECT \\* FROM blocks WHERE time >= \\$1 AND time < \\$2 ORDER BY height ASC LIMIT \\$3 OFFSET \\$4$").
+		WithArgs(startTime, endTime, 1000, 0).WillReturnRows(rows)
+
+	blocks, err = driver.ReadBlocks(&types.ReadBlocksOptions{Order: types.AscendantOrder, StartTime: startTime, EndTime: endTime})
+	c.NoError(err)
+	c.Len(blocks, 1)
+
+	blocks, err = driver.ReadBlocks(&types.ReadBlocksOptions{Order: "height; DROP TABLE blocks;"})
+	c.ErrorIs(err, ErrInvalidOrder)
+	c.Empty(blocks)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadBlocksByProposer(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"id", "hash", "height", "time", "proposer_address", "tx_count", "tx_total",
+		"accounts_quantity", "apps_quantity", "nodes_quantity", "took"}).
+		AddRow(1, "ABCD", 21, time.Date(1999, time.July, 21, 0, 0, 0, 0, time.Local), "A2143929B30CBC3E7A30C2DE06B385BCF874134B", 21, 100, 212121, 2121, 2323, "2121")
+
+	mock.ExpectQuery("^SELECT \\* FROM blocks WHERE height >= \\$1 AND proposer_address = \\$2 ORDER BY height DESC LIMIT \\$3 OFFSET \\$4$").
+		WithArgs(21, "A2143929B30CBC3E7A30C2DE06B385BCF874134B", 1000, 0).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	blocks, err := driver.ReadBlocksByProposer("a2143929b30cbc3e7a30c2de06b385bcf874134b", &types.ReadBlocksByProposerOptions{FromHeight: 21})
+	c.NoError(err)
+	c.Len(blocks, 1)
+
+	blocks, err = driver.ReadBlocksByProposer("';DROP TABLE blocks;", nil)
+	c.Equal(ErrInvalidAddress, err)
+	c.Empty(blocks)
+
+	mock.ExpectQuery("^SELECT \\* FROM blocks WHERE proposer_address = \\$1").WillReturnError(errors.New("dummy error"))
+
+	blocks, err = driver.ReadBlocksByProposer("A2143929B30CBC3E7A30C2DE06B385BCF874134B", nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(blocks)
+
+	c.NoError(mock.ExpectationsWereMet())
 }
 
 func TestPostgresDriver_ReadBlockByHash(t *testing.T) {
@@ -187,6 +237,55 @@ func TestPostgresDriver_ReadBlockByHeight(t *testing.T) {
 	c.Empty(block)
 }
 
+func TestPostgresDriver_ReadBlockByTime(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	blockTime := time.Date(1999, time.July, 21, 0, 0, 0, 0, time.UTC)
+
+	newRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "hash", "height", "time", "proposer_address", "tx_count", "tx_total",
+			"accounts_quantity", "apps_quantity", "nodes_quantity", "took"}).
+			AddRow(1, "ABCD", 21, blockTime, "ABCD", 21, 100, 212121, 2121, 2323, "2121")
+	}
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	mock.ExpectQuery("^SELECT \\* FROM blocks WHERE time <= \\$1 ORDER BY time DESC").WithArgs(blockTime).WillReturnRows(newRows())
+
+	block, err := driver.ReadBlockByTime(blockTime, "")
+	c.NoError(err)
+	c.Equal(21, block.Height)
+
+	mock.ExpectQuery("^SELECT \\* FROM blocks WHERE time >= \\$1 ORDER BY time ASC").WithArgs(blockTime).WillReturnRows(newRows())
+
+	block, err = driver.ReadBlockByTime(blockTime, types.AfterTimeLookup)
+	c.NoError(err)
+	c.Equal(21, block.Height)
+
+	mock.ExpectQuery("AS candidates ORDER BY ABS").WithArgs(blockTime).WillReturnRows(newRows())
+
+	block, err = driver.ReadBlockByTime(blockTime, types.NearestTimeLookup)
+	c.NoError(err)
+	c.Equal(21, block.Height)
+
+	mock.ExpectQuery("^SELECT \\* FROM blocks WHERE time <= \\$1").WillReturnError(errors.New("dummy error"))
+
+	block, err = driver.ReadBlockByTime(blockTime, types.BeforeTimeLookup)
+	c.EqualError(err, "dummy error")
+	c.Empty(block)
+
+	block, err = driver.ReadBlockByTime(blockTime, "around")
+	c.ErrorIs(err, ErrInvalidTimeLookup)
+	c.Empty(block)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
 func TestPostgresDriver_GetMaxHeightInBlocks(t *testing.T) {
 	c := require.New(t)
 
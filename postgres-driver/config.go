@@ -0,0 +1,120 @@
+package postgresdriver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+var (
+	// ErrNoConnectionString error when config has no connection string
+	ErrNoConnectionString = errors.New("no connection string")
+	// ErrInvalidSchema error when given schema is not a valid identifier
+	ErrInvalidSchema = errors.New("invalid schema")
+
+	identifierRegex = regexp.MustCompile("^[a-zA-Z_][a-zA-Z0-9_]*$")
+)
+
+// Config struct handler of the options to build a PostgresDriver
+// zero values keep database/sql and server defaults
+type Config struct {
+	// ConnectionString is the primary connection string, either URL or key=value format
+	ConnectionString string
+	// ReplicaConnectionString is the optional read replica connection string
+	ReplicaConnectionString string
+	MaxOpenConnections      int
+	MaxIdleConnections      int
+	ConnectionMaxLifetime   time.Duration
+	StatementTimeout        time.Duration
+	// Network is the chain the driver reads and writes, several networks can share a database
+	// each one on its own schema
+	Network types.Network
+	// Schema is set as the search_path of every connection
+	// defaults to the network name when network is set
+	Schema          string
+	ApplicationName string
+	// PartitionSize is the heights range of each partition of the per height tables
+	// zero disables partitioning, tables must be created with PARTITION BY RANGE (height) to enable it
+	PartitionSize int
+}
+
+// getSchema returns the schema set on config or the network name when no schema is set
+func (c Config) getSchema() string {
+	if c.Schema == "" {
+		return string(c.Network)
+	}
+
+	return c.Schema
+}
+
+func (c Config) validate() error {
+	if c.ConnectionString == "" {
+		return ErrNoConnectionString
+	}
+
+	schema := c.getSchema()
+
+	if schema != "" && !identifierRegex.MatchString(schema) {
+		return fmt.Errorf("%w: %q", ErrInvalidSchema, schema)
+	}
+
+	return nil
+}
+
+// buildConnectionString adds the config run-time parameters to the given connection string
+func (c Config) buildConnectionString(connectionString string) (string, error) {
+	if strings.HasPrefix(connectionString, "postgres://") || strings.HasPrefix(connectionString, "postgresql://") {
+		parsedConnectionString, err := pq.ParseURL(connectionString)
+		if err != nil {
+			return "", err
+		}
+
+		connectionString = parsedConnectionString
+	}
+
+	params := []string{connectionString}
+
+	schema := c.getSchema()
+
+	if schema != "" {
+		params = append(params, fmt.Sprintf("search_path=%s", schema))
+	}
+
+	if c.ApplicationName != "" {
+		params = append(params, fmt.Sprintf("application_name=%s", quoteConnectionValue(c.ApplicationName)))
+	}
+
+	if c.StatementTimeout > 0 {
+		params = append(params, fmt.Sprintf("statement_timeout=%d", c.StatementTimeout.Milliseconds()))
+	}
+
+	return strings.TrimSpace(strings.Join(params, " ")), nil
+}
+
+func (c Config) configurePool(db *sqlx.DB) {
+	if c.MaxOpenConnections > 0 {
+		db.SetMaxOpenConns(c.MaxOpenConnections)
+	}
+
+	if c.MaxIdleConnections > 0 {
+		db.SetMaxIdleConns(c.MaxIdleConnections)
+	}
+
+	if c.ConnectionMaxLifetime > 0 {
+		db.SetConnMaxLifetime(c.ConnectionMaxLifetime)
+	}
+}
+
+// quoteConnectionValue quotes value to be used in a key=value connection string
+func quoteConnectionValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+
+	return fmt.Sprintf("'%s'", value)
+}
@@ -0,0 +1,110 @@
+package postgresdriver
+
+import (
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	insertConversionWarningsScript = `
+	INSERT into conversion_warnings (height, entity, field, value, hash, address, message)
+	(
+		select * from unnest($1::int[], $2::text[], $3::text[], $4::text[], $5::text[], $6::text[], $7::text[])
+	)`
+	selectConversionWarningsScript = `
+	SELECT * FROM conversion_warnings ORDER BY height DESC, id
+	LIMIT $1 OFFSET $2`
+	selectConversionWarningsByHeightScript = `
+	SELECT * FROM conversion_warnings WHERE height = $1 ORDER BY id
+	LIMIT $2 OFFSET $3`
+)
+
+// dbConversionWarning is struct handler for the conversion warning with types needed for Postgres processing
+type dbConversionWarning struct {
+	ID      int            `db:"id"`
+	Height  int            `db:"height"`
+	Entity  string         `db:"entity"`
+	Field   string         `db:"field"`
+	Value   string         `db:"value"`
+	Hash    sql.NullString `db:"hash"`
+	Address sql.NullString `db:"address"`
+	Message string         `db:"message"`
+}
+
+func (w *dbConversionWarning) toIndexerConversionWarning() *types.ConversionWarning {
+	return &types.ConversionWarning{
+		Height:  w.Height,
+		Entity:  w.Entity,
+		Field:   w.Field,
+		Value:   w.Value,
+		Hash:    w.Hash.String,
+		Address: w.Address.String,
+		Message: w.Message,
+	}
+}
+
+// WriteConversionWarnings inserts given conversion warnings to the database
+func (d *PostgresDriver) WriteConversionWarnings(warnings []*types.ConversionWarning) error {
+	var entities, fields, values, messages []string
+	var hashes, addresses []sql.NullString
+	var heights []int64
+
+	for _, warning := range warnings {
+		heights = append(heights, int64(warning.Height))
+		entities = append(entities, warning.Entity)
+		fields = append(fields, warning.Field)
+		values = append(values, warning.Value)
+		hashes = append(hashes, newSQLNullString(warning.Hash))
+		addresses = append(addresses, newSQLNullString(warning.Address))
+		messages = append(messages, warning.Message)
+	}
+
+	_, err := d.Exec(insertConversionWarningsScript,
+		pq.Int64Array(heights),
+		pq.StringArray(entities),
+		pq.StringArray(fields),
+		pq.StringArray(values),
+		pq.Array(hashes),
+		pq.Array(addresses),
+		pq.StringArray(messages))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReadConversionWarnings returns the conversion warnings recorded with pagination
+// height 0 returns warnings of every height, last height first
+// Optional values defaults: page: 1, perPage: 1000, height: all heights
+func (d *PostgresDriver) ReadConversionWarnings(options *types.ReadConversionWarningsOptions) ([]*types.ConversionWarning, error) {
+	if options == nil {
+		options = &types.ReadConversionWarningsOptions{}
+	}
+
+	perPage := getPerPageValue(options.PerPage)
+	move := getMoveValue(perPage, getPageValue(options.Page))
+
+	query, args := selectConversionWarningsScript, []any{perPage, move}
+
+	if options.Height > 0 {
+		query, args = selectConversionWarningsByHeightScript, []any{options.Height, perPage, move}
+	}
+
+	var warnings []*dbConversionWarning
+
+	err := d.reader().Select(&warnings, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexerWarnings []*types.ConversionWarning
+
+	for _, warning := range warnings {
+		indexerWarnings = append(indexerWarnings, warning.toIndexerConversionWarning())
+	}
+
+	return indexerWarnings, nil
+}
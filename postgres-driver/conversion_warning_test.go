@@ -0,0 +1,85 @@
+package postgresdriver
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_WriteConversionWarnings(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	mock.ExpectExec("INSERT into conversion_warnings").WithArgs(pq.Int64Array([]int64{21}), pq.StringArray([]string{"node"}),
+		pq.StringArray([]string{"tokens"}), pq.StringArray([]string{"12ab"}), pq.Array([]sql.NullString{{}}),
+		pq.Array([]sql.NullString{{String: "00353abd21ef72725b295ba5a9a5eb6082548e21", Valid: true}}), pq.StringArray([]string{"invalid number"})).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	warningsToSend := []*types.ConversionWarning{
+		{
+			Height:  21,
+			Entity:  "node",
+			Field:   "tokens",
+			Value:   "12ab",
+			Address: "00353abd21ef72725b295ba5a9a5eb6082548e21",
+			Message: "invalid number",
+		},
+	}
+
+	err = driver.WriteConversionWarnings(warningsToSend)
+	c.NoError(err)
+
+	mock.ExpectExec("INSERT into conversion_warnings").WillReturnError(errors.New("dummy error"))
+
+	err = driver.WriteConversionWarnings(warningsToSend)
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadConversionWarnings(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"id", "height", "entity", "field", "value", "hash", "address", "message"}).
+		AddRow(1, 21, "transaction", "stdTx.fee", "", "ABCD", nil, "missing field")
+
+	mock.ExpectQuery("^SELECT (.+) FROM conversion_warnings WHERE height = \\$1").WithArgs(21, 10, 0).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	warnings, err := driver.ReadConversionWarnings(&types.ReadConversionWarningsOptions{Height: 21, PerPage: 10})
+	c.NoError(err)
+	c.Equal([]*types.ConversionWarning{
+		{
+			Height:  21,
+			Entity:  "transaction",
+			Field:   "stdTx.fee",
+			Hash:    "ABCD",
+			Message: "missing field",
+		},
+	}, warnings)
+
+	mock.ExpectQuery("^SELECT (.+) FROM conversion_warnings ORDER BY height DESC").WithArgs(1000, 0).WillReturnError(errors.New("dummy error"))
+
+	warnings, err = driver.ReadConversionWarnings(nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(warnings)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,273 @@
+package postgresdriver
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-go/utils"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	counterpartyFlowsScript = `
+	SELECT %[1]s AS address, %[2]s AS counterparty, '%[3]s' AS direction, COUNT(*) AS tx_quantity,
+		COALESCE(SUM(amount), 0) AS total, MIN(height) AS first_height, MAX(height) AS last_height
+	FROM transactions WHERE %[4]s
+	GROUP BY %[1]s, %[2]s`
+	selectCounterpartyFlowsScript = `
+	SELECT * FROM (%s) AS flows ORDER BY total DESC, counterparty, direction
+	LIMIT %s OFFSET %s`
+	// selectCounterpartyGraphScript keeps the flows of a hop with the biggest totals to counterparties not reached yet
+	selectCounterpartyGraphScript = `
+	SELECT * FROM (%s) AS flows WHERE NOT (counterparty = ANY(%s))
+	ORDER BY total DESC, address, counterparty, direction LIMIT %s`
+
+	defaultCounterpartyDepth         = 2
+	maxCounterpartyDepth             = 5
+	defaultCounterpartyGraphHopLimit = 100
+	maxCounterpartyGraphHopLimit     = 1000
+)
+
+var (
+	// ErrInvalidFlowDirection error when given flow direction is not supported
+	ErrInvalidFlowDirection = errors.New("invalid flow direction")
+	// ErrInvalidDepth error when given depth is out of the supported range
+	ErrInvalidDepth = fmt.Errorf("depth must be between 1 and %d", maxCounterpartyDepth)
+	// ErrInvalidMaxCounterparties error when given max counterparties is out of the supported range
+	ErrInvalidMaxCounterparties = fmt.Errorf("max counterparties must be between 1 and %d", maxCounterpartyGraphHopLimit)
+)
+
+// flowColumns struct handler of the transaction columns holding the address and the counterparty of a flow direction
+type flowColumns struct {
+	address      string
+	counterparty string
+}
+
+var (
+	flowDirectionColumns = map[types.FlowDirection]flowColumns{
+		types.SentFlowDirection:     {address: "from_address", counterparty: "to_address"},
+		types.ReceivedFlowDirection: {address: "to_address", counterparty: "from_address"},
+	}
+	flowDirections = []types.FlowDirection{types.SentFlowDirection, types.ReceivedFlowDirection}
+)
+
+// dbCounterpartyFlow is struct handler for the counterparty flow with types needed for Postgres processing
+type dbCounterpartyFlow struct {
+	Address      string `db:"address"`
+	Counterparty string `db:"counterparty"`
+	Direction    string `db:"direction"`
+	TXQuantity   int    `db:"tx_quantity"`
+	Total        string `db:"total"`
+	FirstHeight  int    `db:"first_height"`
+	LastHeight   int    `db:"last_height"`
+}
+
+func (f *dbCounterpartyFlow) toIndexerCounterpartyFlow(depth int) *types.CounterpartyFlow {
+	total := new(big.Int)
+	total, _ = total.SetString(f.Total, 10)
+
+	return &types.CounterpartyFlow{
+		Address:              f.Address,
+		Counterparty:         f.Counterparty,
+		Direction:            types.FlowDirection(f.Direction),
+		Depth:                depth,
+		TransactionsQuantity: f.TXQuantity,
+		Total:                total,
+		FirstHeight:          f.FirstHeight,
+		LastHeight:           f.LastHeight,
+	}
+}
+
+func getFlowDirections(direction types.FlowDirection) ([]types.FlowDirection, error) {
+	if direction == "" {
+		return flowDirections, nil
+	}
+
+	if _, ok := flowDirectionColumns[direction]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFlowDirection, direction)
+	}
+
+	return []types.FlowDirection{direction}, nil
+}
+
+// getCounterpartyFlowsQuery returns the flows query of the addresses in given heights range
+// filters hold the query arguments
+func getCounterpartyFlowsQuery(addresses []string, direction types.FlowDirection, fromHeight, toHeight int) (string, *filterQuery, error) {
+	directions, err := getFlowDirections(direction)
+	if err != nil {
+		return "", nil, err
+	}
+
+	filters := getBlockRangeFilters(fromHeight, toHeight, time.Time{}, time.Time{})
+	addressesArg := filters.addArg(pq.StringArray(addresses))
+
+	var queries []string
+
+	for _, direction := range directions {
+		columns := flowDirectionColumns[direction]
+		conditions := append([]string{
+			fmt.Sprintf("%s = ANY(%s)", columns.address, addressesArg),
+			fmt.Sprintf("%s IS NOT NULL", columns.counterparty),
+		}, filters.conditions...)
+
+		queries = append(queries, fmt.Sprintf(counterpartyFlowsScript, columns.address, columns.counterparty, direction,
+			strings.Join(conditions, " AND ")))
+	}
+
+	return strings.Join(queries, " UNION ALL "), filters, nil
+}
+
+// ReadCounterpartyFlows returns the transactions of given address aggregated by counterparty and direction
+// flows are sorted by total amount in descendant order
+// Optional values defaults: page: 1, perPage: 1000, direction: both, heights range: all
+func (d *PostgresDriver) ReadCounterpartyFlows(address string, options *types.ReadCounterpartyFlowsOptions) ([]*types.CounterpartyFlow, error) {
+	if !utils.ValidateAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	if options == nil {
+		options = &types.ReadCounterpartyFlowsOptions{}
+	}
+
+	flowsQuery, filters, err := getCounterpartyFlowsQuery([]string{address}, options.Direction, options.FromHeight, options.ToHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	perPage := getPerPageValue(options.PerPage)
+	move := getMoveValue(perPage, getPageValue(options.Page))
+	query := fmt.Sprintf(selectCounterpartyFlowsScript, flowsQuery, filters.addArg(perPage), filters.addArg(move))
+
+	var flows []*dbCounterpartyFlow
+
+	err = d.reader().Select(&flows, query, filters.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexerFlows []*types.CounterpartyFlow
+
+	for _, flow := range flows {
+		indexerFlows = append(indexerFlows, flow.toIndexerCounterpartyFlow(1))
+	}
+
+	return indexerFlows, nil
+}
+
+// ReadCounterpartyGraph returns the flows reachable from given address hop by hop up to the given depth
+// each hop reads the flows of the counterparties first reached in the previous hop,
+// keeping the max counterparties flows with the biggest totals to addresses not reached in previous hops
+// Optional values defaults: depth: 2, maxCounterparties: 100, direction: both, heights range: all
+func (d *PostgresDriver) ReadCounterpartyGraph(address string, options *types.ReadCounterpartyGraphOptions) ([]*types.CounterpartyFlow, error) {
+	if !utils.ValidateAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	if options == nil {
+		options = &types.ReadCounterpartyGraphOptions{}
+	}
+
+	depth, hopLimit, err := getCounterpartyGraphLimits(options)
+	if err != nil {
+		return nil, err
+	}
+
+	reached := map[string]bool{address: true}
+	frontier := []string{address}
+
+	var graph []*types.CounterpartyFlow
+
+	for hop := 1; hop <= depth && len(frontier) > 0; hop++ {
+		flows, err := d.readCounterpartyGraphHop(frontier, reached, hopLimit, options)
+		if err != nil {
+			return nil, err
+		}
+
+		graph, frontier = addCounterpartyGraphHop(graph, flows, hop, reached)
+	}
+
+	return graph, nil
+}
+
+// getCounterpartyGraphLimits returns the depth and the flows limit of each hop of the options
+func getCounterpartyGraphLimits(options *types.ReadCounterpartyGraphOptions) (int, int, error) {
+	depth := options.Depth
+	if depth == 0 {
+		depth = defaultCounterpartyDepth
+	}
+
+	if depth < 1 || depth > maxCounterpartyDepth {
+		return 0, 0, fmt.Errorf("%w: %d", ErrInvalidDepth, depth)
+	}
+
+	hopLimit := options.MaxCounterparties
+	if hopLimit == 0 {
+		hopLimit = defaultCounterpartyGraphHopLimit
+	}
+
+	if hopLimit < 1 || hopLimit > maxCounterpartyGraphHopLimit {
+		return 0, 0, fmt.Errorf("%w: %d", ErrInvalidMaxCounterparties, hopLimit)
+	}
+
+	return depth, hopLimit, nil
+}
+
+// addCounterpartyGraphHop adds the hop flows to counterparties not reached in previous hops to the graph
+// returns the graph and the counterparties first reached in the hop
+func addCounterpartyGraphHop(graph []*types.CounterpartyFlow, flows []*dbCounterpartyFlow, hop int,
+	reached map[string]bool) ([]*types.CounterpartyFlow, []string) {
+	hopReached := make(map[string]bool)
+
+	var hopCounterparties []string
+
+	for _, flow := range flows {
+		if reached[flow.Counterparty] {
+			continue
+		}
+
+		graph = append(graph, flow.toIndexerCounterpartyFlow(hop))
+
+		if !hopReached[flow.Counterparty] {
+			hopReached[flow.Counterparty] = true
+			hopCounterparties = append(hopCounterparties, flow.Counterparty)
+		}
+	}
+
+	for _, counterparty := range hopCounterparties {
+		reached[counterparty] = true
+	}
+
+	return graph, hopCounterparties
+}
+
+func (d *PostgresDriver) readCounterpartyGraphHop(addresses []string, reached map[string]bool, limit int,
+	options *types.ReadCounterpartyGraphOptions) ([]*dbCounterpartyFlow, error) {
+	flowsQuery, filters, err := getCounterpartyFlowsQuery(addresses, options.Direction, options.FromHeight, options.ToHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var reachedAddresses []string
+
+	for reachedAddress := range reached {
+		reachedAddresses = append(reachedAddresses, reachedAddress)
+	}
+
+	sort.Strings(reachedAddresses)
+
+	query := fmt.Sprintf(selectCounterpartyGraphScript, flowsQuery, filters.addArg(pq.StringArray(reachedAddresses)), filters.addArg(limit))
+
+	var flows []*dbCounterpartyFlow
+
+	err = d.reader().Select(&flows, query, filters.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return flows, nil
+}
@@ -0,0 +1,190 @@
+package postgresdriver
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+var counterpartyFlowColumns = []string{"address", "counterparty", "direction", "tx_quantity", "total", "first_height", "last_height"}
+
+func TestPostgresDriver_ReadCounterpartyFlows(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	rows := sqlmock.NewRows(counterpartyFlowColumns).
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e21", "00353abd21ef72725b295ba5a9a5eb6082548e22", "sent", 3, "2121", 5, 21).
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e21", "00353abd21ef72725b295ba5a9a5eb6082548e23", "received", 1, "100", 7, 7)
+
+	mock.ExpectQuery("^SELECT \\* FROM \\((.+)from_address = ANY\\(\\$3\\) AND to_address IS NOT NULL AND height >= \\$1 AND height <= \\$2(.+)"+
+		"UNION ALL(.+)to_address = ANY\\(\\$3\\) AND from_address IS NOT NULL(.+)ORDER BY total DESC(.+)LIMIT \\$4 OFFSET \\$5$").
+		WithArgs(5, 21, pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}), 7, 7).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	flows, err := driver.ReadCounterpartyFlows("00353abd21ef72725b295ba5a9a5eb6082548e21", &types.ReadCounterpartyFlowsOptions{
+		Page:       2,
+		PerPage:    7,
+		FromHeight: 5,
+		ToHeight:   21,
+	})
+	c.NoError(err)
+	c.Len(flows, 2)
+	c.Equal(&types.CounterpartyFlow{
+		Address:              "00353abd21ef72725b295ba5a9a5eb6082548e21",
+		Counterparty:         "00353abd21ef72725b295ba5a9a5eb6082548e22",
+		Direction:            types.SentFlowDirection,
+		Depth:                1,
+		TransactionsQuantity: 3,
+		Total:                big.NewInt(2121),
+		FirstHeight:          5,
+		LastHeight:           21,
+	}, flows[0])
+	c.Equal(types.ReceivedFlowDirection, flows[1].Direction)
+
+	mock.ExpectQuery("^SELECT \\* FROM \\((.+)to_address = ANY\\(\\$1\\) AND from_address IS NOT NULL\n(.+)\\) AS flows").
+		WithArgs(pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}), 1000, 0).WillReturnError(errors.New("dummy error"))
+
+	flows, err = driver.ReadCounterpartyFlows("00353abd21ef72725b295ba5a9a5eb6082548e21", &types.ReadCounterpartyFlowsOptions{
+		Direction: types.ReceivedFlowDirection,
+	})
+	c.EqualError(err, "dummy error")
+	c.Empty(flows)
+
+	flows, err = driver.ReadCounterpartyFlows("00353abd21ef72725b295ba5a9a5eb6082548e21", &types.ReadCounterpartyFlowsOptions{Direction: "both"})
+	c.ErrorIs(err, ErrInvalidFlowDirection)
+	c.Empty(flows)
+
+	flows, err = driver.ReadCounterpartyFlows("00353abd21ef72725b295ba5a9a5eb6082548e2", nil)
+	c.Equal(ErrInvalidAddress, err)
+	c.Empty(flows)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadCounterpartyGraph(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	rows := sqlmock.NewRows(counterpartyFlowColumns).
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e21", "00353abd21ef72725b295ba5a9a5eb6082548e22", "sent", 3, "2121", 5, 21).
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e21", "00353abd21ef72725b295ba5a9a5eb6082548e23", "sent", 1, "100", 7, 7)
+
+	mock.ExpectQuery("^SELECT \\* FROM \\((.+)from_address = ANY\\(\\$1\\)(.+)\\) AS flows WHERE NOT \\(counterparty = ANY\\(\\$2\\)\\)\\s+"+
+		"ORDER BY total DESC, address, counterparty, direction LIMIT \\$3$").
+		WithArgs(pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}),
+			pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}), 100).
+		WillReturnRows(rows)
+
+	rows = sqlmock.NewRows(counterpartyFlowColumns).
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e22", "00353abd21ef72725b295ba5a9a5eb6082548e24", "sent", 1, "2000", 8, 8).
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e23", "00353abd21ef72725b295ba5a9a5eb6082548e24", "sent", 1, "90", 8, 8)
+
+	mock.ExpectQuery("^SELECT \\* FROM \\((.+)from_address = ANY\\(\\$1\\)(.+)\\) AS flows WHERE NOT \\(counterparty = ANY\\(\\$2\\)\\)").
+		WithArgs(pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e22", "00353abd21ef72725b295ba5a9a5eb6082548e23"}),
+			pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21", "00353abd21ef72725b295ba5a9a5eb6082548e22",
+				"00353abd21ef72725b295ba5a9a5eb6082548e23"}), 100).
+		WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	graph, err := driver.ReadCounterpartyGraph("00353abd21ef72725b295ba5a9a5eb6082548e21", &types.ReadCounterpartyGraphOptions{
+		Direction: types.SentFlowDirection,
+	})
+	c.NoError(err)
+	c.Len(graph, 4)
+	c.Equal(1, graph[1].Depth)
+	c.Equal("00353abd21ef72725b295ba5a9a5eb6082548e24", graph[2].Counterparty)
+	c.Equal(2, graph[2].Depth)
+	c.Equal("00353abd21ef72725b295ba5a9a5eb6082548e23", graph[3].Address)
+	c.Equal(2, graph[3].Depth)
+
+	rows = sqlmock.NewRows(counterpartyFlowColumns)
+
+	mock.ExpectQuery("^SELECT \\* FROM \\((.+)UNION ALL(.+)\\) AS flows WHERE").
+		WithArgs(21, pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}),
+			pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}), 100).
+		WillReturnRows(rows)
+
+	graph, err = driver.ReadCounterpartyGraph("00353abd21ef72725b295ba5a9a5eb6082548e21", &types.ReadCounterpartyGraphOptions{
+		Depth:    5,
+		ToHeight: 21,
+	})
+	c.NoError(err)
+	c.Empty(graph)
+
+	mock.ExpectQuery("^SELECT \\* FROM (.+) AS flows WHERE").WillReturnError(errors.New("dummy error"))
+
+	graph, err = driver.ReadCounterpartyGraph("00353abd21ef72725b295ba5a9a5eb6082548e21", nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(graph)
+
+	graph, err = driver.ReadCounterpartyGraph("00353abd21ef72725b295ba5a9a5eb6082548e21", &types.ReadCounterpartyGraphOptions{Depth: 6})
+	c.ErrorIs(err, ErrInvalidDepth)
+	c.Empty(graph)
+
+	graph, err = driver.ReadCounterpartyGraph("00353abd21ef72725b295ba5a9a5eb6082548e21", &types.ReadCounterpartyGraphOptions{MaxCounterparties: 1001})
+	c.ErrorIs(err, ErrInvalidMaxCounterparties)
+	c.Empty(graph)
+
+	graph, err = driver.ReadCounterpartyGraph("00353abd21ef72725b295ba5a9a5eb6082548e21", &types.ReadCounterpartyGraphOptions{Direction: "both"})
+	c.ErrorIs(err, ErrInvalidFlowDirection)
+	c.Empty(graph)
+
+	graph, err = driver.ReadCounterpartyGraph("00353abd21ef72725b295ba5a9a5eb6082548e2", nil)
+	c.Equal(ErrInvalidAddress, err)
+	c.Empty(graph)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadCounterpartyGraphMaxCounterparties(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	rows := sqlmock.NewRows(counterpartyFlowColumns).
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e21", "00353abd21ef72725b295ba5a9a5eb6082548e22", "sent", 3, "2121", 5, 21)
+
+	mock.ExpectQuery("LIMIT \\$3$").
+		WithArgs(pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}),
+			pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}), 1).
+		WillReturnRows(rows)
+
+	rows = sqlmock.NewRows(counterpartyFlowColumns).
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e22", "00353abd21ef72725b295ba5a9a5eb6082548e24", "sent", 1, "2000", 8, 8)
+
+	// the second hop only follows the counterparty kept on the first one
+	mock.ExpectQuery("LIMIT \\$3$").
+		WithArgs(pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e22"}),
+			pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21", "00353abd21ef72725b295ba5a9a5eb6082548e22"}), 1).
+		WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	graph, err := driver.ReadCounterpartyGraph("00353abd21ef72725b295ba5a9a5eb6082548e21", &types.ReadCounterpartyGraphOptions{
+		Direction:         types.SentFlowDirection,
+		MaxCounterparties: 1,
+	})
+	c.NoError(err)
+	c.Len(graph, 2)
+	c.Equal("00353abd21ef72725b295ba5a9a5eb6082548e24", graph[1].Counterparty)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,107 @@
+package postgresdriver
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	selectFeeStatsScript = `
+	SELECT %s AS bucket_start, transactions.message_type, coin->>'denom' AS denomination, COUNT(*) AS tx_quantity,
+		SUM((coin->>'amount')::numeric) AS total, TRUNC(AVG((coin->>'amount')::numeric)) AS average
+	FROM transactions JOIN blocks USING (height), jsonb_array_elements(transactions.fees) AS coin
+	%s
+	GROUP BY 1, 2, 3
+	ORDER BY 1 DESC, 2, 3
+	LIMIT %s OFFSET %s`
+	noBucketStart = "NULL::timestamptz"
+)
+
+// dbFeeStats is struct handler for the fee stats with types needed for Postgres processing
+type dbFeeStats struct {
+	BucketStart  sql.NullTime `db:"bucket_start"`
+	MessageType  string       `db:"message_type"`
+	Denomination string       `db:"denomination"`
+	TXQuantity   int          `db:"tx_quantity"`
+	Total        string       `db:"total"`
+	Average      string       `db:"average"`
+}
+
+func (s *dbFeeStats) toIndexerFeeStats() *types.FeeStats {
+	total := new(big.Int)
+	total, _ = total.SetString(s.Total, 10)
+
+	average := new(big.Int)
+	average, _ = average.SetString(s.Average, 10)
+
+	return &types.FeeStats{
+		BucketStart:          s.BucketStart.Time,
+		MessageType:          s.MessageType,
+		Denomination:         s.Denomination,
+		TransactionsQuantity: s.TXQuantity,
+		Total:                total,
+		Average:              average,
+	}
+}
+
+// getFeeStatsQuery returns the fee stats query and its arguments for given options
+func getFeeStatsQuery(options *types.ReadFeeStatsOptions) (string, []any, error) {
+	filters := getBlockRangeFilters(options.FromHeight, options.ToHeight, options.StartTime, options.EndTime)
+
+	if options.MessageType != "" {
+		filters.addFilter("transactions.message_type = %s", options.MessageType)
+	}
+
+	if options.Denomination != "" {
+		filters.addFilter("coin->>'denom' = %s", options.Denomination)
+	}
+
+	bucketStart := noBucketStart
+
+	if options.Interval != "" {
+		intervalField, ok := statsIntervalFields[options.Interval]
+		if !ok {
+			return "", nil, fmt.Errorf("%w: %q", ErrInvalidStatsInterval, options.Interval)
+		}
+
+		bucketStart = fmt.Sprintf("date_trunc(%s, blocks.time)", filters.addArg(intervalField))
+	}
+
+	perPage := getPerPageValue(options.PerPage)
+	move := getMoveValue(perPage, getPageValue(options.Page))
+	query := fmt.Sprintf(selectFeeStatsScript, bucketStart, filters.where(), filters.addArg(perPage), filters.addArg(move))
+
+	return query, filters.args, nil
+}
+
+// ReadFeeStats returns the total and average fees grouped by message type and denomination
+// grouped by time buckets as well when an interval is set, newest buckets first
+// Optional values defaults: page: 1, perPage: 1000, interval: none, range: all transactions
+func (d *PostgresDriver) ReadFeeStats(options *types.ReadFeeStatsOptions) ([]*types.FeeStats, error) {
+	if options == nil {
+		options = &types.ReadFeeStatsOptions{}
+	}
+
+	query, args, err := getFeeStatsQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	var allStats []*dbFeeStats
+
+	err = d.reader().Select(&allStats, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexerStats []*types.FeeStats
+
+	for _, stats := range allStats {
+		indexerStats = append(indexerStats, stats.toIndexerFeeStats())
+	}
+
+	return indexerStats, nil
+}
@@ -0,0 +1,66 @@
+package postgresdriver
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_ReadFeeStats(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	bucketStart := time.Date(1999, time.July, 21, 0, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"bucket_start", "message_type", "denomination", "tx_quantity", "total", "average"}).
+		AddRow(bucketStart, "pos/Send", "upokt", 3, "30000", "10000")
+
+	mock.ExpectQuery("SELECT date_trunc\\(\\$4, blocks.time\\) AS bucket_start, (.+) WHERE height >= \\$1 AND transactions.message_type = \\$2 AND coin->>'denom' = \\$3 GROUP BY 1, 2, 3 (.+) LIMIT \\$5 OFFSET \\$6").
+		WithArgs(21, "pos/Send", "upokt", "day", 1000, 0).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	stats, err := driver.ReadFeeStats(&types.ReadFeeStatsOptions{
+		Interval:     types.DailyStatsInterval,
+		MessageType:  "pos/Send",
+		Denomination: "upokt",
+		FromHeight:   21,
+	})
+	c.NoError(err)
+	c.Len(stats, 1)
+	c.Equal(bucketStart, stats[0].BucketStart)
+	c.Equal(3, stats[0].TransactionsQuantity)
+	c.Equal(big.NewInt(30000), stats[0].Total)
+	c.Equal(big.NewInt(10000), stats[0].Average)
+
+	rows = sqlmock.NewRows([]string{"bucket_start", "message_type", "denomination", "tx_quantity", "total", "average"}).
+		AddRow(nil, "pos/Send", "upokt", 3, "30000", "10000")
+
+	mock.ExpectQuery("SELECT NULL::timestamptz AS bucket_start, (.+) GROUP BY 1, 2, 3").WithArgs(1000, 0).WillReturnRows(rows)
+
+	stats, err = driver.ReadFeeStats(nil)
+	c.NoError(err)
+	c.Len(stats, 1)
+	c.True(stats[0].BucketStart.IsZero())
+
+	mock.ExpectQuery("SELECT NULL::timestamptz AS bucket_start").WithArgs(1000, 0).WillReturnError(errors.New("dummy error"))
+
+	stats, err = driver.ReadFeeStats(&types.ReadFeeStatsOptions{})
+	c.EqualError(err, "dummy error")
+	c.Empty(stats)
+
+	stats, err = driver.ReadFeeStats(&types.ReadFeeStatsOptions{Interval: "weekly"})
+	c.ErrorIs(err, ErrInvalidStatsInterval)
+	c.Empty(stats)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
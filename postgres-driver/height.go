@@ -0,0 +1,53 @@
+package postgresdriver
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+// WriteHeight inserts the block, transactions, accounts, nodes and apps of the height in a single transaction
+// hook runs in the transaction after the height values are inserted, its error rolls back the whole height
+func (d *PostgresDriver) WriteHeight(data *types.HeightData, hook func(tx *sql.Tx) error) error {
+	tx, err := d.Beginx()
+	if err != nil {
+		return err
+	}
+
+	err = writeHeight(tx, data)
+	if err == nil && hook != nil {
+		err = hook(tx.Tx)
+	}
+
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func writeHeight(tx *sqlx.Tx, data *types.HeightData) error {
+	err := writeBlock(tx, data.Block)
+	if err != nil {
+		return err
+	}
+
+	err = writeTransactions(tx, data.Transactions)
+	if err != nil {
+		return err
+	}
+
+	err = writeAccounts(tx, data.Accounts)
+	if err != nil {
+		return err
+	}
+
+	err = writeNodes(tx, data.Nodes)
+	if err != nil {
+		return err
+	}
+
+	return writeApps(tx, data.Apps)
+}
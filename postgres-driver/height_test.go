@@ -0,0 +1,74 @@
+package postgresdriver
+
+import (
+	"database/sql"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_WriteHeight(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	data := &types.HeightData{
+		Height: 21,
+		Block: &types.Block{
+			Hash:   "ABCD",
+			Height: 21,
+		},
+		Accounts: []*types.Account{
+			{
+				Address: "00353abd21ef72725b295ba5a9a5eb6082548e21",
+				Height:  21,
+				Balance: big.NewInt(212121),
+			},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT into blocks").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT into accounts").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO enrichments").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = driver.WriteHeight(data, func(tx *sql.Tx) error {
+		_, err := tx.Exec("INSERT INTO enrichments (height) VALUES ($1)", 21)
+		return err
+	})
+	c.NoError(err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT into blocks").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT into accounts").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectRollback()
+
+	err = driver.WriteHeight(data, func(tx *sql.Tx) error {
+		return errors.New("forced failure")
+	})
+	c.EqualError(err, "forced failure")
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT into blocks").WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	err = driver.WriteHeight(data, nil)
+	c.EqualError(err, "dummy error")
+
+	mock.ExpectBegin().WillReturnError(errors.New("dummy error"))
+
+	err = driver.WriteHeight(data, nil)
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -4,8 +4,10 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"math/big"
 
 	"github.com/pokt-foundation/pocket-go/provider"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
 )
 
 var (
@@ -56,3 +58,68 @@ func (s *stdTx) Scan(value any) error {
 
 	return json.Unmarshal(b, &s)
 }
+
+// dbCoin is struct handler for a coin with types needed for JSONB processing
+// amounts are kept as strings to not lose precision on JSON numbers
+type dbCoin struct {
+	Amount       string `json:"amount"`
+	Denomination string `json:"denom"`
+}
+
+// coins is a wrapper for a list of coins to implement interfaces for JSONB parsing
+type coins []*dbCoin
+
+// Make the coins type implement the driver.Valuer interface. This method
+// returns the JSON-encoded representation of the list, empty lists are encoded as an empty array.
+func (c coins) Value() (driver.Value, error) {
+	if c == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]*dbCoin(c))
+}
+
+// Make the coins type implement the sql.Scanner interface. This method
+// decodes a JSON-encoded value into the list, null values are decoded as an empty list.
+func (c *coins) Scan(value any) error {
+	if value == nil {
+		*c = nil
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return ErrByteTypeAssertionFailed
+	}
+
+	return json.Unmarshal(b, (*[]*dbCoin)(c))
+}
+
+func (c coins) toIndexerCoins() []*types.Coin {
+	var indexerCoins []*types.Coin
+
+	for _, coin := range c {
+		amount := new(big.Int)
+		amount, _ = amount.SetString(coin.Amount, 10)
+
+		indexerCoins = append(indexerCoins, &types.Coin{
+			Denomination: coin.Denomination,
+			Amount:       amount,
+		})
+	}
+
+	return indexerCoins
+}
+
+func convertIndexerCoinsToDBCoins(indexerCoins []*types.Coin) coins {
+	var dbCoins coins
+
+	for _, coin := range indexerCoins {
+		dbCoins = append(dbCoins, &dbCoin{
+			Amount:       coin.Amount.String(),
+			Denomination: coin.Denomination,
+		})
+	}
+
+	return dbCoins
+}
@@ -0,0 +1,210 @@
+package postgresdriver
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	// nativeFeeExpression is the upokt fee of a transaction, transactions saved before fees only have their first coin on fee
+	nativeFeeExpression = `COALESCE((SELECT SUM((coin->>'amount')::numeric) FROM jsonb_array_elements(fees) AS coin
+		WHERE coin->>'denom' = '` + types.NativeDenomination + `'), CASE WHEN fee_denomination = '` + types.NativeDenomination + `' THEN fee END, 0)`
+	// addHeightNetworkStatsScript adds the block and transactions of the height to the bucket holding it
+	// heights inside the bucket heights range are taken as already added, so writing a height more than once is safe
+	// an address is only counted as active if no other height of the bucket has a transaction of it
+	addHeightNetworkStatsScript = `
+	WITH block AS (
+		SELECT date_trunc($1, time) AS bucket_start, height FROM blocks WHERE height = $2
+	), height_txs AS (
+		SELECT from_address, to_address, amount, ` + nativeFeeExpression + ` AS fee FROM transactions WHERE height = $2
+	), txs_stats AS (
+		SELECT COUNT(*) AS tx_quantity, COALESCE(SUM(fee), 0) AS fees, COALESCE(SUM(amount), 0) AS volume FROM height_txs
+	), accounts_stats AS (
+		SELECT COUNT(DISTINCT addresses.address) AS active_accounts FROM block, (
+			SELECT from_address AS address FROM height_txs
+			UNION ALL
+			SELECT to_address AS address FROM height_txs
+		) AS addresses
+		WHERE addresses.address IS NOT NULL AND NOT EXISTS (
+			SELECT 1 FROM transactions JOIN blocks USING (height)
+			WHERE blocks.time >= block.bucket_start AND blocks.time < block.bucket_start + ('1 ' || $1)::interval
+				AND transactions.height <> $2
+				AND (transactions.from_address = addresses.address OR transactions.to_address = addresses.address)
+		)
+	)
+	INSERT INTO %s AS stats (bucket_start, start_height, end_height, blocks_quantity, tx_quantity, fees, volume, active_accounts)
+	SELECT block.bucket_start, block.height, block.height, 1, txs_stats.tx_quantity, txs_stats.fees, txs_stats.volume,
+		accounts_stats.active_accounts
+	FROM block, txs_stats, accounts_stats
+	ON CONFLICT (bucket_start) DO UPDATE SET
+		start_height = LEAST(stats.start_height, EXCLUDED.start_height), end_height = GREATEST(stats.end_height, EXCLUDED.end_height),
+		blocks_quantity = stats.blocks_quantity + 1, tx_quantity = stats.tx_quantity + EXCLUDED.tx_quantity,
+		fees = stats.fees + EXCLUDED.fees, volume = stats.volume + EXCLUDED.volume,
+		active_accounts = stats.active_accounts + EXCLUDED.active_accounts
+	WHERE EXCLUDED.start_height < stats.start_height OR EXCLUDED.start_height > stats.end_height`
+	// rebuildNetworkStatsScript recomputes every bucket holding a block in the heights range
+	rebuildNetworkStatsScript = `
+	WITH buckets AS (
+		SELECT DISTINCT date_trunc($1, time) AS bucket_start FROM blocks WHERE height >= $2 AND height <= $3
+	), bucket_blocks AS (
+		SELECT buckets.bucket_start, blocks.height FROM buckets
+		JOIN blocks ON blocks.time >= buckets.bucket_start AND blocks.time < buckets.bucket_start + ('1 ' || $1)::interval
+	), bucket_txs AS (
+		SELECT bucket_blocks.bucket_start, transactions.from_address, transactions.to_address, transactions.amount,
+			` + nativeFeeExpression + ` AS fee
+		FROM bucket_blocks JOIN transactions ON transactions.height = bucket_blocks.height
+	), txs_stats AS (
+		SELECT bucket_start, COUNT(*) AS tx_quantity, SUM(fee) AS fees, SUM(amount) AS volume FROM bucket_txs GROUP BY bucket_start
+	), accounts_stats AS (
+		SELECT bucket_start, COUNT(DISTINCT address) AS active_accounts FROM (
+			SELECT bucket_start, from_address AS address FROM bucket_txs
+			UNION ALL
+			SELECT bucket_start, to_address AS address FROM bucket_txs
+		) AS addresses WHERE address IS NOT NULL GROUP BY bucket_start
+	)
+	INSERT INTO %s (bucket_start, start_height, end_height, blocks_quantity, tx_quantity, fees, volume, active_accounts)
+	SELECT bucket_blocks.bucket_start, MIN(bucket_blocks.height), MAX(bucket_blocks.height), COUNT(*),
+		COALESCE(MAX(txs_stats.tx_quantity), 0), COALESCE(MAX(txs_stats.fees), 0), COALESCE(MAX(txs_stats.volume), 0),
+		COALESCE(MAX(accounts_stats.active_accounts), 0)
+	FROM bucket_blocks
+	LEFT JOIN txs_stats USING (bucket_start)
+	LEFT JOIN accounts_stats USING (bucket_start)
+	GROUP BY bucket_blocks.bucket_start
+	ON CONFLICT (bucket_start) DO UPDATE SET
+		start_height = EXCLUDED.start_height, end_height = EXCLUDED.end_height, blocks_quantity = EXCLUDED.blocks_quantity,
+		tx_quantity = EXCLUDED.tx_quantity, fees = EXCLUDED.fees, volume = EXCLUDED.volume, active_accounts = EXCLUDED.active_accounts`
+	selectNetworkStatsScript = `
+	SELECT bucket_start, start_height, end_height, blocks_quantity, tx_quantity, fees, volume, active_accounts
+	FROM %s %s ORDER BY bucket_start %s
+	LIMIT %s OFFSET %s`
+)
+
+// networkStatsTable struct handler of the rollup table of a stats interval
+type networkStatsTable struct {
+	name  string
+	field string
+}
+
+var (
+	networkStatsTables = map[types.StatsInterval]networkStatsTable{
+		types.HourlyStatsInterval: {name: "hourly_stats", field: "hour"},
+		types.DailyStatsInterval:  {name: "daily_stats", field: "day"},
+	}
+	networkStatsIntervals = []types.StatsInterval{types.HourlyStatsInterval, types.DailyStatsInterval}
+)
+
+// dbNetworkStats is struct handler for the network stats with types needed for Postgres processing
+type dbNetworkStats struct {
+	BucketStart    time.Time `db:"bucket_start"`
+	StartHeight    int       `db:"start_height"`
+	EndHeight      int       `db:"end_height"`
+	BlocksQuantity int       `db:"blocks_quantity"`
+	TXQuantity     int       `db:"tx_quantity"`
+	Fees           string    `db:"fees"`
+	Volume         string    `db:"volume"`
+	ActiveAccounts int       `db:"active_accounts"`
+}
+
+func (s *dbNetworkStats) toIndexerNetworkStats() *types.NetworkStats {
+	fees := new(big.Int)
+	fees, _ = fees.SetString(s.Fees, 10)
+
+	volume := new(big.Int)
+	volume, _ = volume.SetString(s.Volume, 10)
+
+	return &types.NetworkStats{
+		BucketStart:          s.BucketStart,
+		StartHeight:          s.StartHeight,
+		EndHeight:            s.EndHeight,
+		BlocksQuantity:       s.BlocksQuantity,
+		TransactionsQuantity: s.TXQuantity,
+		Fees:                 fees,
+		Volume:               volume,
+		ActiveAccounts:       s.ActiveAccounts,
+	}
+}
+
+// WriteNetworkStats adds the block and transactions of given height to the hourly and daily stats buckets holding it
+// block and transactions of the height need to be written first, writing a height again does not change the buckets
+// heights written out of order leave active accounts approximate until RebuildNetworkStats recomputes their buckets
+func (d *PostgresDriver) WriteNetworkStats(height int) error {
+	return d.execNetworkStatsScript(context.Background(), addHeightNetworkStatsScript, height)
+}
+
+// RebuildNetworkStats recomputes the hourly and daily stats buckets holding blocks in the given heights range
+// heights are inclusive
+func (d *PostgresDriver) RebuildNetworkStats(ctx context.Context, fromHeight, toHeight int) error {
+	return d.execNetworkStatsScript(ctx, rebuildNetworkStatsScript, fromHeight, toHeight)
+}
+
+// execNetworkStatsScript runs the script on the table of every interval in a single transaction
+// the script gets the interval field as first argument followed by args
+func (d *PostgresDriver) execNetworkStatsScript(ctx context.Context, script string, args ...any) error {
+	tx, err := d.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, interval := range networkStatsIntervals {
+		table := networkStatsTables[interval]
+
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(script, table.name), append([]any{table.field}, args...)...)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ReadNetworkStats returns the network stats buckets of given interval with pagination
+// Optional values defaults: page: 1, perPage: 1000, order: desc, time range: all
+func (d *PostgresDriver) ReadNetworkStats(interval types.StatsInterval, options *types.ReadNetworkStatsOptions) ([]*types.NetworkStats, error) {
+	table, ok := networkStatsTables[interval]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidStatsInterval, interval)
+	}
+
+	if options == nil {
+		options = &types.ReadNetworkStatsOptions{}
+	}
+
+	order, err := getOrderValue(options.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &filterQuery{}
+
+	if !options.StartTime.IsZero() {
+		filters.addFilter("bucket_start >= %s", options.StartTime)
+	}
+
+	if !options.EndTime.IsZero() {
+		filters.addFilter("bucket_start < %s", options.EndTime)
+	}
+
+	perPage := getPerPageValue(options.PerPage)
+	move := getMoveValue(perPage, getPageValue(options.Page))
+	query := fmt.Sprintf(selectNetworkStatsScript, table.name, filters.where(), order, filters.addArg(perPage), filters.addArg(move))
+
+	var allStats []*dbNetworkStats
+
+	err = d.reader().Select(&allStats, query, filters.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexerStats []*types.NetworkStats
+
+	for _, stats := range allStats {
+		indexerStats = append(indexerStats, stats.toIndexerNetworkStats())
+	}
+
+	return indexerStats, nil
+}
@@ -0,0 +1,87 @@
+package postgresdriver
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_WriteNetworkStats(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("WHERE coin->>'denom' = 'upokt'(.+)FROM transactions WHERE height = \\$2(.+)INSERT INTO hourly_stats AS stats(.+)"+
+		"blocks_quantity = stats.blocks_quantity \\+ 1(.+)WHERE EXCLUDED.start_height < stats.start_height").
+		WithArgs("hour", 21).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO daily_stats AS stats").WithArgs("day", 21).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	err = driver.WriteNetworkStats(21)
+	c.NoError(err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("WHERE coin->>'denom' = 'upokt'(.+)INSERT INTO hourly_stats \\(").WithArgs("hour", 1, 100).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO daily_stats").WithArgs("day", 1, 100).WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	err = driver.RebuildNetworkStats(context.Background(), 1, 100)
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadNetworkStats(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	bucketStart := time.Date(1999, time.July, 21, 0, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"bucket_start", "start_height", "end_height", "blocks_quantity", "tx_quantity", "fees",
+		"volume", "active_accounts"}).
+		AddRow(bucketStart, 21, 116, 96, 300, "3000000", "462000000", 50)
+
+	mock.ExpectQuery("FROM daily_stats WHERE bucket_start >= \\$1 ORDER BY bucket_start ASC LIMIT \\$2 OFFSET \\$3").
+		WithArgs(bucketStart, 1000, 0).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	stats, err := driver.ReadNetworkStats(types.DailyStatsInterval, &types.ReadNetworkStatsOptions{
+		StartTime: bucketStart,
+		Order:     types.AscendantOrder,
+	})
+	c.NoError(err)
+	c.Len(stats, 1)
+	c.Equal(bucketStart, stats[0].BucketStart)
+	c.Equal(big.NewInt(462000000), stats[0].Volume)
+	c.Equal(50, stats[0].ActiveAccounts)
+
+	mock.ExpectQuery("FROM hourly_stats ORDER BY bucket_start DESC").WithArgs(1000, 0).WillReturnError(errors.New("dummy error"))
+
+	stats, err = driver.ReadNetworkStats(types.HourlyStatsInterval, nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(stats)
+
+	stats, err = driver.ReadNetworkStats("weekly", nil)
+	c.ErrorIs(err, ErrInvalidStatsInterval)
+	c.Empty(stats)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -1,8 +1,12 @@
 package postgresdriver
 
 import (
+	"database/sql"
+	"fmt"
 	"math/big"
+	"strings"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"github.com/pokt-foundation/pocket-go/utils"
 	"github.com/pokt-foundation/pocket-indexer-lib/types"
@@ -10,26 +14,22 @@ import (
 
 const (
 	insertNodesScript = `
-	INSERT into nodes (address, height, jailed, public_key, service_url, tokens)
+	INSERT into nodes (address, height, jailed, public_key, service_url, tokens, chains, output_address, unstaking_time)
 	(
-		select * from unnest($1::text[], $2::int[], $3::boolean[], $4::text[], $5::text[], $6::numeric[])
+		select address, height, jailed, public_key, service_url, tokens, string_to_array(NULLIF(chains, ''), ','), output_address, unstaking_time
+		from unnest($1::text[], $2::int[], $3::boolean[], $4::text[], $5::text[], $6::numeric[], $7::text[], $8::text[], $9::timestamptz[])
+		as t(address, height, jailed, public_key, service_url, tokens, chains, output_address, unstaking_time)
 	)`
-	selectNodesScript = `
-	DECLARE nodes_cursor CURSOR FOR SELECT * FROM nodes WHERE height = (SELECT MAX(height) FROM nodes);
-	MOVE absolute %d from nodes_cursor;
-	FETCH %d FROM nodes_cursor;
-	`
-	selectNodesByHeightScript = `
-	DECLARE nodes_cursor CURSOR FOR SELECT * FROM nodes WHERE height = '%d';
-	MOVE absolute %d from nodes_cursor;
-	FETCH %d FROM nodes_cursor;
-	`
 	selectNodeByAddressScript          = "SELECT * FROM nodes WHERE address = $1 AND height = (SELECT MAX(height) FROM nodes)"
 	selectNodeByAddressAndHeightScript = "SELECT * FROM nodes WHERE address = $1 AND height = $2"
 	selectCountFromNodes               = "SELECT COUNT(*) FROM nodes WHERE height = (SELECT MAX(height) FROM nodes)"
 	selectCountFromNodesByHeight       = "SELECT COUNT(*) FROM nodes WHERE height = $1"
 )
 
+var nodeSortColumns = map[types.NodeSortField]string{
+	types.NodeSortByTokens: "tokens",
+}
+
 // dbNode is struct handler for the node with types needed for Postgres processing
 type dbNode struct {
 	ID         int    `db:"id"`
@@ -39,6 +39,11 @@ type dbNode struct {
 	PublicKey  string `db:"public_key"`
 	ServiceURL string `db:"service_url"`
 	Tokens     string `db:"tokens"`
+	// Chains are sent as a joined string to be able to use SQL's Unnest with this field
+	// and stored as an array by the insert
+	Chains        pq.StringArray `db:"chains"`
+	OutputAddress sql.NullString `db:"output_address"`
+	UnstakingTime sql.NullTime   `db:"unstaking_time"`
 }
 
 func (n *dbNode) toIndexerNode() *types.Node {
@@ -46,29 +51,45 @@ func (n *dbNode) toIndexerNode() *types.Node {
 	tokens, _ = tokens.SetString(n.Tokens, 10)
 
 	return &types.Node{
-		Address:    n.Address,
-		Height:     n.Height,
-		Jailed:     n.Jailed,
-		PublicKey:  n.PublicKey,
-		ServiceURL: n.ServiceURL,
-		Tokens:     tokens,
+		Address:       n.Address,
+		Height:        n.Height,
+		Jailed:        n.Jailed,
+		PublicKey:     n.PublicKey,
+		ServiceURL:    n.ServiceURL,
+		Tokens:        tokens,
+		Chains:        n.Chains,
+		OutputAddress: n.OutputAddress.String,
+		UnstakingTime: n.UnstakingTime.Time,
 	}
 }
 
 func convertIndexerNodeToDBNode(indexerNode *types.Node) *dbNode {
 	return &dbNode{
-		Address:    indexerNode.Address,
-		Height:     indexerNode.Height,
-		Jailed:     indexerNode.Jailed,
-		PublicKey:  indexerNode.PublicKey,
-		ServiceURL: indexerNode.ServiceURL,
-		Tokens:     indexerNode.Tokens.String(),
+		Address:       indexerNode.Address,
+		Height:        indexerNode.Height,
+		Jailed:        indexerNode.Jailed,
+		PublicKey:     indexerNode.PublicKey,
+		ServiceURL:    indexerNode.ServiceURL,
+		Tokens:        indexerNode.Tokens.String(),
+		Chains:        indexerNode.Chains,
+		OutputAddress: newSQLNullString(indexerNode.OutputAddress),
+		UnstakingTime: newSQLNullTime(indexerNode.UnstakingTime),
 	}
 }
 
 // WriteNodes inserts given nodes to the database
 func (d *PostgresDriver) WriteNodes(nodes []*types.Node) error {
-	var addresses, publicKeys, serviceURLs, allTokens []string
+	return writeNodes(d, nodes)
+}
+
+// writeNodes inserts nothing when there are no nodes
+func writeNodes(db sqlx.Execer, nodes []*types.Node) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	var addresses, publicKeys, serviceURLs, allTokens, allChains []string
+	var outputAddresses, unstakingTimes []sql.NullString
 	var heights []int64
 	var jaileds []bool
 
@@ -80,14 +101,20 @@ func (d *PostgresDriver) WriteNodes(nodes []*types.Node) error {
 		publicKeys = append(publicKeys, dbNode.PublicKey)
 		serviceURLs = append(serviceURLs, dbNode.ServiceURL)
 		allTokens = append(allTokens, dbNode.Tokens)
+		allChains = append(allChains, strings.Join(dbNode.Chains, chainsSeparator))
+		outputAddresses = append(outputAddresses, dbNode.OutputAddress)
+		unstakingTimes = append(unstakingTimes, formatSQLNullTime(dbNode.UnstakingTime))
 	}
 
-	_, err := d.Exec(insertNodesScript, pq.StringArray(addresses),
+	_, err := db.Exec(insertNodesScript, pq.StringArray(addresses),
 		pq.Int64Array(heights),
 		pq.BoolArray(jaileds),
 		pq.StringArray(publicKeys),
 		pq.StringArray(serviceURLs),
-		pq.StringArray(allTokens))
+		pq.StringArray(allTokens),
+		pq.StringArray(allChains),
+		pq.Array(outputAddresses),
+		pq.Array(unstakingTimes))
 	if err != nil {
 		return err
 	}
@@ -109,12 +136,12 @@ func (d *PostgresDriver) ReadNodeByAddress(address string, options *types.ReadNo
 	}
 
 	if height == 0 {
-		err := d.Get(&dbNode, selectNodeByAddressScript, address)
+		err := d.reader().Get(&dbNode, selectNodeByAddressScript, address)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		err := d.Get(&dbNode, selectNodeByAddressAndHeightScript, address, height)
+		err := d.reader().Get(&dbNode, selectNodeByAddressAndHeightScript, address, height)
 		if err != nil {
 			return nil, err
 		}
@@ -123,37 +150,53 @@ func (d *PostgresDriver) ReadNodeByAddress(address string, options *types.ReadNo
 	return dbNode.toIndexerNode(), nil
 }
 
-// ReadNodes returns nodes with given height
-// Optional values defaults: page: 1, perPage: 1000, height: last height
-func (d *PostgresDriver) ReadNodes(options *types.ReadNodesOptions) ([]*types.Node, error) {
-	perPage := defaultPerPage
-	page := defaultPage
-	height := 0
+func getNodesPageQuery(options *types.ReadNodesOptions) (string, []any, error) {
+	sortColumn, ok := nodeSortColumns[options.SortBy]
+	if !ok && options.SortBy != "" {
+		return "", nil, fmt.Errorf("%w: %q", ErrInvalidSortField, options.SortBy)
+	}
 
-	if options != nil {
-		perPage = getPerPageValue(options.PerPage)
-		page = getPageValue(options.Page)
-		height = options.Height
+	sort, err := getSortValue(sortColumn, options.Order)
+	if err != nil {
+		return "", nil, err
 	}
 
-	move := getMoveValue(perPage, page)
+	filters := &filterQuery{}
+	filters.addHeightFilter("nodes", options.Height)
 
-	tx, err := d.Beginx()
-	if err != nil {
-		return nil, err
+	if options.Jailed != nil {
+		filters.addFilter("jailed = %s", *options.Jailed)
 	}
 
-	query := getHeightOptionalQuery(selectNodesByHeightScript, selectNodesScript,
-		height, move, perPage)
+	if options.MinTokens != nil {
+		filters.addFilter("tokens >= %s::numeric", options.MinTokens.String())
+	}
 
-	var nodes []*dbNode
+	if options.Chain != "" {
+		filters.addFilter("chains @> ARRAY[%s]", options.Chain)
+	}
+
+	perPage := getPerPageValue(options.PerPage)
+	query, args := buildPageQuery("nodes", filters, sort, perPage, getMoveValue(perPage, getPageValue(options.Page)))
+
+	return query, args, nil
+}
 
-	err = tx.Select(&nodes, query)
+// ReadNodes returns nodes with given height
+// Optional values defaults: page: 1, perPage: 1000, height: last height, order: desc when sorted
+func (d *PostgresDriver) ReadNodes(options *types.ReadNodesOptions) ([]*types.Node, error) {
+	if options == nil {
+		options = &types.ReadNodesOptions{}
+	}
+
+	query, args, err := getNodesPageQuery(options)
 	if err != nil {
 		return nil, err
 	}
 
-	err = tx.Commit()
+	var nodes []*dbNode
+
+	err = d.reader().Select(&nodes, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package postgresdriver
 
 import (
+	"database/sql"
 	"errors"
 	"math/big"
 	"testing"
@@ -21,19 +22,22 @@ func TestPostgresDriver_WriteNodes(t *testing.T) {
 
 	mock.ExpectExec("INSERT into nodes").WithArgs(pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}), pq.Int64Array([]int64{21}),
 		pq.BoolArray([]bool{false}), pq.StringArray([]string{"01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903"}),
-		pq.StringArray([]string{"https://dummy.com:6045"}), pq.StringArray([]string{"212121"})).
+		pq.StringArray([]string{"https://dummy.com:6045"}), pq.StringArray([]string{"212121"}), pq.StringArray([]string{"0001,0021"}),
+		pq.Array([]sql.NullString{{String: "00353abd21ef72725b295ba5a9a5eb6082548e22", Valid: true}}), pq.Array([]sql.NullString{{}})).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	driver := NewPostgresDriverFromSQLDBInstance(db)
 
 	nodesToSend := []*types.Node{
 		{
-			Address:    "00353abd21ef72725b295ba5a9a5eb6082548e21",
-			Height:     21,
-			Jailed:     false,
-			PublicKey:  "01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903",
-			ServiceURL: "https://dummy.com:6045",
-			Tokens:     big.NewInt(212121),
+			Address:       "00353abd21ef72725b295ba5a9a5eb6082548e21",
+			Height:        21,
+			Jailed:        false,
+			PublicKey:     "01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903",
+			ServiceURL:    "https://dummy.com:6045",
+			Tokens:        big.NewInt(212121),
+			Chains:        []string{"0001", "0021"},
+			OutputAddress: "00353abd21ef72725b295ba5a9a5eb6082548e22",
 		},
 	}
 
@@ -42,7 +46,8 @@ func TestPostgresDriver_WriteNodes(t *testing.T) {
 
 	mock.ExpectExec("INSERT into nodes").WithArgs(pq.StringArray([]string{"00353abd21ef72725b295ba5a9a5eb6082548e21"}), pq.Int64Array([]int64{21}),
 		pq.BoolArray([]bool{false}), pq.StringArray([]string{"01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903"}),
-		pq.StringArray([]string{"https://dummy.com:6045"}), pq.StringArray([]string{"212121"})).
+		pq.StringArray([]string{"https://dummy.com:6045"}), pq.StringArray([]string{"212121"}), pq.StringArray([]string{"0001,0021"}),
+		pq.Array([]sql.NullString{{String: "00353abd21ef72725b295ba5a9a5eb6082548e22", Valid: true}}), pq.Array([]sql.NullString{{}})).
 		WillReturnError(errors.New("dummy error"))
 
 	err = driver.WriteNodes(nodesToSend)
@@ -110,9 +115,7 @@ func TestPostgresDriver_ReadNodes(t *testing.T) {
 		AddRow(1, "00353abd21ef72725b295ba5a9a5eb6082548e22", 21, false,
 			"01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903", "https://dummy.com:6043", "212121")
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnRows(rows)
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM nodes WHERE height = \\$1").WithArgs(21, 7, 140).WillReturnRows(rows)
 
 	driver := NewPostgresDriverFromSQLDBInstance(db)
 
@@ -120,13 +123,48 @@ func TestPostgresDriver_ReadNodes(t *testing.T) {
 	c.NoError(err)
 	c.Len(nodes, 2)
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnError(errors.New("dummy error"))
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM nodes WHERE height = \\(SELECT MAX").WithArgs(1000, 0).WillReturnError(errors.New("dummy error"))
 
 	nodes, err = driver.ReadNodes(&types.ReadNodesOptions{})
 	c.EqualError(err, "dummy error")
 	c.Empty(nodes)
+
+	rows = sqlmock.NewRows([]string{"id", "address", "height", "jailed", "public_key", "service_url", "tokens"}).
+		AddRow(1, "00353abd21ef72725b295ba5a9a5eb6082548e21", 21, false,
+			"01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903", "https://dummy.com:6045", "212121")
+
+	mock.ExpectQuery("^SELECT \\* FROM nodes WHERE height = \\(SELECT MAX\\(height\\) FROM nodes\\) AND jailed = \\$1 AND tokens >= \\$2::numeric ORDER BY tokens ASC, address LIMIT \\$3 OFFSET \\$4$").
+		WithArgs(false, "15000", 1000, 0).WillReturnRows(rows)
+
+	jailed := false
+
+	nodes, err = driver.ReadNodes(&types.ReadNodesOptions{
+		SortBy:    types.NodeSortByTokens,
+		Order:     types.AscendantOrder,
+		Jailed:    &jailed,
+		MinTokens: big.NewInt(15000),
+	})
+	c.NoError(err)
+	c.Len(nodes, 1)
+
+	rows = sqlmock.NewRows([]string{"id", "address", "height", "jailed", "public_key", "service_url", "tokens", "chains", "output_address", "unstaking_time"}).
+		AddRow(1, "00353abd21ef72725b295ba5a9a5eb6082548e21", 21, false,
+			"01473af96ffc54c447f79d2fa06ee79e68c0dbd5b8257da25bf99dd89309c903", "https://dummy.com:6045", "212121", "{0009}", nil, nil)
+
+	mock.ExpectQuery("^SELECT \\* FROM nodes WHERE height = \\$1 AND jailed = \\$2 AND chains @> ARRAY\\[\\$3\\] LIMIT \\$4 OFFSET \\$5$").
+		WithArgs(21, false, "0009", 1000, 0).WillReturnRows(rows)
+
+	nodes, err = driver.ReadNodes(&types.ReadNodesOptions{Height: 21, Jailed: &jailed, Chain: "0009"})
+	c.NoError(err)
+	c.Len(nodes, 1)
+	c.Equal([]string{"0009"}, nodes[0].Chains)
+	c.Empty(nodes[0].OutputAddress)
+
+	nodes, err = driver.ReadNodes(&types.ReadNodesOptions{SortBy: "service_url"})
+	c.ErrorIs(err, ErrInvalidSortField)
+	c.Empty(nodes)
+
+	c.NoError(mock.ExpectationsWereMet())
 }
 
 func TestPostgresDriver_GetNodesQuantity(t *testing.T) {
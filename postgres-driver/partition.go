@@ -0,0 +1,112 @@
+package postgresdriver
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	createHeightPartitionScript = "CREATE TABLE IF NOT EXISTS %[1]s_p%[2]d PARTITION OF %[1]s FOR VALUES FROM (%[2]d) TO (%[3]d)"
+	detachHeightPartitionScript = "ALTER TABLE %[1]s DETACH PARTITION %[1]s_p%[2]d"
+)
+
+// partitionedTables are the per height tables range partitioned by height
+// they need to be created with PARTITION BY RANGE (height) for partitioning to be enabled
+var partitionedTables = []string{"accounts", "nodes", "apps", "transactions"}
+
+// getPartitionFrom returns the first height of the partition holding given height
+func (d *PostgresDriver) getPartitionFrom(height int) int {
+	return height - height%d.partitionSize
+}
+
+// CreateHeightPartitions creates the partitions holding given height and the next range
+// on every partitioned table, partitions already created are left untouched
+// heights can be indexed in any order, each range is created once per driver
+// it is a no-op when partitioning is not enabled
+func (d *PostgresDriver) CreateHeightPartitions(height int) error {
+	if d.partitionSize <= 0 {
+		return nil
+	}
+
+	from := d.getPartitionFrom(height)
+
+	d.partitionMutex.Lock()
+	defer d.partitionMutex.Unlock()
+
+	if d.createdPartitions[from] {
+		return nil
+	}
+
+	tx, err := d.Beginx()
+	if err != nil {
+		return err
+	}
+
+	for _, partitionFrom := range []int{from, from + d.partitionSize} {
+		err = d.createPartitions(tx, partitionFrom)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	if d.createdPartitions == nil {
+		d.createdPartitions = make(map[int]bool)
+	}
+
+	d.createdPartitions[from] = true
+
+	return nil
+}
+
+func (d *PostgresDriver) createPartitions(tx *sqlx.Tx, from int) error {
+	for _, table := range partitionedTables {
+		_, err := tx.Exec(fmt.Sprintf(createHeightPartitionScript, table, from, from+d.partitionSize))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DetachHeightPartitions detaches the partitions holding given height from every partitioned table
+// detached partitions keep their data as standalone tables that can be archived or dropped
+func (d *PostgresDriver) DetachHeightPartitions(height int) error {
+	if d.partitionSize <= 0 {
+		return ErrPartitioningDisabled
+	}
+
+	from := d.getPartitionFrom(height)
+
+	tx, err := d.Beginx()
+	if err != nil {
+		return err
+	}
+
+	for _, table := range partitionedTables {
+		_, err = tx.Exec(fmt.Sprintf(detachHeightPartitionScript, table, from))
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	d.partitionMutex.Lock()
+	defer d.partitionMutex.Unlock()
+
+	delete(d.createdPartitions, from)
+
+	return nil
+}
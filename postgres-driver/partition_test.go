@@ -0,0 +1,114 @@
+package postgresdriver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_CreateHeightPartitions(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	err = driver.CreateHeightPartitions(21)
+	c.NoError(err)
+
+	driver.partitionSize = 10
+
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS accounts_p20 PARTITION OF accounts FOR VALUES FROM \\(20\\) TO \\(30\\)").
+		WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	err = driver.CreateHeightPartitions(21)
+	c.EqualError(err, "dummy error")
+
+	mock.ExpectBegin()
+
+	for _, table := range partitionedTables {
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + table + "_p20 PARTITION OF " + table + " FOR VALUES FROM \\(20\\) TO \\(30\\)").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	for _, table := range partitionedTables {
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + table + "_p30 PARTITION OF " + table + " FOR VALUES FROM \\(30\\) TO \\(40\\)").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	mock.ExpectCommit()
+
+	err = driver.CreateHeightPartitions(21)
+	c.NoError(err)
+
+	// partitions for the range are already created
+	err = driver.CreateHeightPartitions(29)
+	c.NoError(err)
+
+	// a lower height indexed after a higher one still gets its partitions
+	mock.ExpectBegin()
+
+	for _, table := range partitionedTables {
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + table + "_p0 PARTITION OF " + table + " FOR VALUES FROM \\(0\\) TO \\(10\\)").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	for _, table := range partitionedTables {
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS " + table + "_p10 PARTITION OF " + table + " FOR VALUES FROM \\(10\\) TO \\(20\\)").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	mock.ExpectCommit()
+
+	err = driver.CreateHeightPartitions(5)
+	c.NoError(err)
+
+	err = driver.CreateHeightPartitions(25)
+	c.NoError(err)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_DetachHeightPartitions(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	err = driver.DetachHeightPartitions(21)
+	c.Equal(ErrPartitioningDisabled, err)
+
+	driver.partitionSize = 10
+
+	mock.ExpectBegin()
+
+	for _, table := range partitionedTables {
+		mock.ExpectExec("ALTER TABLE " + table + " DETACH PARTITION " + table + "_p20").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	mock.ExpectCommit()
+
+	err = driver.DetachHeightPartitions(25)
+	c.NoError(err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("ALTER TABLE accounts DETACH PARTITION accounts_p20").WillReturnError(errors.New("dummy error"))
+	mock.ExpectRollback()
+
+	err = driver.DetachHeightPartitions(25)
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
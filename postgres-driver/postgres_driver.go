@@ -2,9 +2,13 @@
 package postgresdriver
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pokt-foundation/pocket-indexer-lib/types"
@@ -21,11 +25,100 @@ var (
 	ErrNoPreviousHeight = errors.New("no previous height stored")
 	// ErrInvalidAddress error when given address is invalid
 	ErrInvalidAddress = errors.New("invalid address")
+	// ErrInvalidOrder error when given order is not supported
+	ErrInvalidOrder = errors.New("invalid order")
+	// ErrInvalidSortField error when given sort field is not supported
+	ErrInvalidSortField = errors.New("invalid sort field")
+	// ErrPartitioningDisabled error when a partition operation is requested without partitioning enabled
+	ErrPartitioningDisabled = errors.New("partitioning is disabled")
+
+	// orderKeywords is the whitelist of orders allowed to be set on queries
+	orderKeywords = map[types.Order]string{
+		types.DescendantOrder: "DESC",
+		types.AscendantOrder:  "ASC",
+	}
 )
 
 // PostgresDriver struct handler for PostgresDB related functions
+// Write methods always use the primary connection pool, Read and Get*Quantity methods
+// use the read replica pool when one is set
 type PostgresDriver struct {
 	*sqlx.DB
+	replica *sqlx.DB
+	network types.Network
+	// schema is the search_path set on the connections, empty when the connection string sets it
+	schema string
+	// connectionString is the primary connection string used by Subscribe listeners
+	connectionString string
+
+	partitionSize  int
+	partitionMutex sync.Mutex
+	// createdPartitions holds the first height of the partition ranges already created
+	createdPartitions map[int]bool
+}
+
+// NewPostgresDriver returns PostgresDriver instance from given config
+// connection pools are health checked before returning
+func NewPostgresDriver(ctx context.Context, config Config) (*PostgresDriver, error) {
+	err := config.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	connectionString, err := config.buildConnectionString(config.ConnectionString)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := openDB(ctx, config, connectionString)
+	if err != nil {
+		return nil, err
+	}
+
+	driver := &PostgresDriver{
+		DB:               db,
+		network:          config.Network,
+		schema:           config.getSchema(),
+		partitionSize:    config.PartitionSize,
+		connectionString: connectionString,
+	}
+
+	if config.ReplicaConnectionString == "" {
+		return driver, nil
+	}
+
+	replicaConnectionString, err := config.buildConnectionString(config.ReplicaConnectionString)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	replica, err := openDB(ctx, config, replicaConnectionString)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	driver.replica = replica
+
+	return driver, nil
+}
+
+func openDB(ctx context.Context, config Config, connectionString string) (*sqlx.DB, error) {
+	db, err := sqlx.Open("postgres", connectionString)
+	if err != nil {
+		return nil, err
+	}
+
+	config.configurePool(db)
+
+	err = db.PingContext(ctx)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
 }
 
 // NewPostgresDriverFromConnectionString returns PostgresDriver instance from connection string
@@ -36,7 +129,28 @@ func NewPostgresDriverFromConnectionString(connectionString string) (*PostgresDr
 	}
 
 	return &PostgresDriver{
-		DB: db,
+		DB:               db,
+		connectionString: connectionString,
+	}, nil
+}
+
+// NewPostgresDriverWithReadReplica returns PostgresDriver instance from the primary and read replica connection strings
+func NewPostgresDriverWithReadReplica(connectionString, replicaConnectionString string) (*PostgresDriver, error) {
+	db, err := sqlx.Open("postgres", connectionString)
+	if err != nil {
+		return nil, err
+	}
+
+	replica, err := sqlx.Open("postgres", replicaConnectionString)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &PostgresDriver{
+		DB:               db,
+		replica:          replica,
+		connectionString: connectionString,
 	}, nil
 }
 
@@ -48,6 +162,53 @@ func NewPostgresDriverFromSQLDBInstance(db *sql.DB) *PostgresDriver {
 	}
 }
 
+// NewPostgresDriverFromSQLDBInstances returns PostgresDriver instance from primary and read replica sql.DB instances
+// mostly used for mocking tests
+func NewPostgresDriverFromSQLDBInstances(db, replica *sql.DB) *PostgresDriver {
+	return &PostgresDriver{
+		DB:      sqlx.NewDb(db, "postgres"),
+		replica: sqlx.NewDb(replica, "postgres"),
+	}
+}
+
+// Primary returns a PostgresDriver that sends every query to the primary, reads included
+// the indexer constructors switch to it, so the indexer reads are not affected by replication lag
+func (d *PostgresDriver) Primary() *PostgresDriver {
+	return &PostgresDriver{
+		DB:               d.DB,
+		network:          d.network,
+		schema:           d.schema,
+		partitionSize:    d.partitionSize,
+		connectionString: d.connectionString,
+	}
+}
+
+// Network returns the network the driver is set to, empty if none was set
+func (d *PostgresDriver) Network() types.Network {
+	return d.network
+}
+
+// Close closes the primary and read replica connection pools
+func (d *PostgresDriver) Close() error {
+	if d.replica != nil {
+		err := d.replica.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return d.DB.Close()
+}
+
+// reader returns the connection pool to be used for reads
+func (d *PostgresDriver) reader() *sqlx.DB {
+	if d.replica != nil {
+		return d.replica
+	}
+
+	return d.DB
+}
+
 func newSQLNullString(value string) sql.NullString {
 	if value == "" {
 		return sql.NullString{}
@@ -59,6 +220,26 @@ func newSQLNullString(value string) sql.NullString {
 	}
 }
 
+func newSQLNullTime(value time.Time) sql.NullTime {
+	if value.IsZero() {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{
+		Time:  value,
+		Valid: true,
+	}
+}
+
+// formatSQLNullTime formats given time to be sent in a text array, null times are kept null
+func formatSQLNullTime(value sql.NullTime) sql.NullString {
+	if !value.Valid {
+		return sql.NullString{}
+	}
+
+	return newSQLNullString(value.Time.Format(time.RFC3339Nano))
+}
+
 func getPerPageValue(optionsPerPage int) int {
 	if optionsPerPage <= 0 {
 		return defaultPerPage
@@ -75,30 +256,110 @@ func getPageValue(optionsPage int) int {
 	return optionsPage
 }
 
-func getOrderValue(optionsOrder types.Order) types.Order {
+// getOrderValue returns the SQL keyword for the given order
+// returns ErrInvalidOrder if order is not on the whitelist
+func getOrderValue(optionsOrder types.Order) (string, error) {
 	if optionsOrder == "" {
-		return defaultOrder
+		optionsOrder = defaultOrder
 	}
 
-	return optionsOrder
+	order, ok := orderKeywords[optionsOrder]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrInvalidOrder, optionsOrder)
+	}
+
+	return order, nil
 }
 
 func getMoveValue(perPage, page int) int {
 	return (page - 1) * perPage
 }
 
-func getHeightOptionalQuery(queryWithHeight, queryWithoutHeight string, height, move, perPage int) string {
+// getHeightOptionalQuery returns the query and its arguments depending on height being set
+// queryWithoutHeight expects perPage and move as $1 and $2, queryWithHeight expects height first
+func getHeightOptionalQuery(queryWithHeight, queryWithoutHeight string, height, move, perPage int) (string, []any) {
+	if height == 0 {
+		return queryWithoutHeight, []any{perPage, move}
+	}
+
+	return queryWithHeight, []any{height, perPage, move}
+}
+
+// filterQuery builds the conditions and arguments of a query with optional filters
+type filterQuery struct {
+	conditions []string
+	args       []any
+}
+
+// addArg adds argument to the query and returns its placeholder
+func (q *filterQuery) addArg(arg any) string {
+	q.args = append(q.args, arg)
+
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// addFilter adds condition to the query, condition's %s is replaced by the argument placeholder
+func (q *filterQuery) addFilter(condition string, arg any) {
+	q.conditions = append(q.conditions, fmt.Sprintf(condition, q.addArg(arg)))
+}
+
+// addCondition adds condition without arguments to the query
+func (q *filterQuery) addCondition(condition string) {
+	q.conditions = append(q.conditions, condition)
+}
+
+func (q *filterQuery) where() string {
+	if len(q.conditions) == 0 {
+		return ""
+	}
+
+	return "WHERE " + strings.Join(q.conditions, " AND ")
+}
+
+// addHeightFilter filters by height, height 0 is last height saved on table
+func (q *filterQuery) addHeightFilter(table string, height int) {
 	if height == 0 {
-		return fmt.Sprintf(queryWithoutHeight, move, perPage)
+		q.addCondition(fmt.Sprintf("height = (SELECT MAX(height) FROM %s)", table))
+		return
+	}
+
+	q.addFilter("height = %s", height)
+}
+
+// getSortValue returns the ORDER BY clause for the given sort column and order
+// address is used as tie breaker so pages are stable, order is validated even without a sort column
+func getSortValue(column string, optionsOrder types.Order) (string, error) {
+	order, err := getOrderValue(optionsOrder)
+	if err != nil {
+		return "", err
+	}
+
+	if column == "" {
+		return "", nil
 	}
 
-	return fmt.Sprintf(queryWithHeight, height, move, perPage)
+	return fmt.Sprintf("ORDER BY %s %s, address", column, order), nil
+}
+
+// buildPageQuery returns the paginated select query of table with given filters and sort
+func buildPageQuery(table string, filters *filterQuery, sort string, perPage, move int) (string, []any) {
+	clauses := []string{fmt.Sprintf("SELECT * FROM %s", table)}
+
+	for _, clause := range []string{filters.where(), sort} {
+		if clause != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+
+	clauses = append(clauses, fmt.Sprintf("LIMIT %s OFFSET %s", filters.addArg(perPage), filters.addArg(move)))
+
+	return strings.Join(clauses, " "), filters.args
 }
 
 func (d *PostgresDriver) getRowWithOptionalHeight(queryWithHeight, queryWithoutHeight string, height int) *sql.Row {
 	if height == 0 {
-		return d.QueryRow(queryWithoutHeight)
+		return d.reader().QueryRow(queryWithoutHeight)
 	}
 
-	return d.QueryRow(queryWithHeight, height)
+	return d.reader().QueryRow(queryWithHeight, height)
 }
@@ -0,0 +1,57 @@
+package postgresdriver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_ReadReplica(t *testing.T) {
+	c := require.New(t)
+
+	primaryDB, primaryMock, err := sqlmock.New()
+	c.NoError(err)
+
+	replicaDB, replicaMock, err := sqlmock.New()
+	c.NoError(err)
+
+	driver := NewPostgresDriverFromSQLDBInstances(primaryDB, replicaDB)
+
+	primaryMock.ExpectExec("INSERT into blocks").WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = driver.WriteBlock(&types.Block{
+		Hash:   "AF5BB3EAFF431E2E5E784D639825979FF20A779725BFE61D4521340F70C3996D0",
+		Height: 21,
+		Time:   time.Date(1999, time.July, 21, 0, 0, 0, 0, time.Local),
+	})
+	c.NoError(err)
+
+	replicaMock.ExpectQuery("^SELECT (.+) FROM blocks").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(100))
+
+	quantity, err := driver.GetBlocksQuantity()
+	c.NoError(err)
+	c.Equal(int64(100), quantity)
+
+	replicaMock.ExpectQuery("^SELECT (.+) FROM accounts").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(21))
+
+	quantity, err = driver.GetAccountsQuantity(nil)
+	c.NoError(err)
+	c.Equal(int64(21), quantity)
+
+	primaryMock.ExpectQuery("^SELECT (.+) FROM blocks").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(99))
+
+	quantity, err = driver.Primary().GetBlocksQuantity()
+	c.NoError(err)
+	c.Equal(int64(99), quantity)
+
+	c.NoError(primaryMock.ExpectationsWereMet())
+	c.NoError(replicaMock.ExpectationsWereMet())
+
+	primaryMock.ExpectClose()
+	replicaMock.ExpectClose()
+
+	c.NoError(driver.Close())
+}
@@ -0,0 +1,66 @@
+package postgresdriver
+
+import (
+	"fmt"
+
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	selectProposerStatsScript = `
+	SELECT proposer_address AS address, COUNT(*) AS blocks_proposed, MIN(height) AS first_height, MAX(height) AS last_height,
+		COUNT(*)::float / SUM(COUNT(*)) OVER () AS share
+	FROM blocks %s
+	GROUP BY proposer_address
+	ORDER BY blocks_proposed DESC, proposer_address
+	LIMIT %s OFFSET %s`
+)
+
+// dbProposerStats is struct handler for the proposer stats with types needed for Postgres processing
+type dbProposerStats struct {
+	Address        string  `db:"address"`
+	BlocksProposed int     `db:"blocks_proposed"`
+	FirstHeight    int     `db:"first_height"`
+	LastHeight     int     `db:"last_height"`
+	Share          float64 `db:"share"`
+}
+
+func (s *dbProposerStats) toIndexerProposerStats() *types.ProposerStats {
+	return &types.ProposerStats{
+		Address:        s.Address,
+		BlocksProposed: s.BlocksProposed,
+		FirstHeight:    s.FirstHeight,
+		LastHeight:     s.LastHeight,
+		Share:          s.Share,
+	}
+}
+
+// ReadProposerStats returns the blocks proposed per validator in the given range
+// sorted by blocks proposed from greater to lower
+// Optional values defaults: page: 1, perPage: 1000, range: all blocks
+func (d *PostgresDriver) ReadProposerStats(options *types.ReadProposerStatsOptions) ([]*types.ProposerStats, error) {
+	if options == nil {
+		options = &types.ReadProposerStatsOptions{}
+	}
+
+	filters := getBlockRangeFilters(options.FromHeight, options.ToHeight, options.StartTime, options.EndTime)
+
+	perPage := getPerPageValue(options.PerPage)
+	move := getMoveValue(perPage, getPageValue(options.Page))
+	query := fmt.Sprintf(selectProposerStatsScript, filters.where(), filters.addArg(perPage), filters.addArg(move))
+
+	var allStats []*dbProposerStats
+
+	err := d.reader().Select(&allStats, query, filters.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexerStats []*types.ProposerStats
+
+	for _, stats := range allStats {
+		indexerStats = append(indexerStats, stats.toIndexerProposerStats())
+	}
+
+	return indexerStats, nil
+}
@@ -0,0 +1,43 @@
+package postgresdriver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_ReadProposerStats(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"address", "blocks_proposed", "first_height", "last_height", "share"}).
+		AddRow("A2143929B30CBC3E7A30C2DE06B385BCF874134B", 3, 21, 27, 0.75).
+		AddRow("B2143929B30CBC3E7A30C2DE06B385BCF874134B", 1, 25, 25, 0.25)
+
+	mock.ExpectQuery("FROM blocks WHERE height >= \\$1 AND height <= \\$2 GROUP BY proposer_address (.+) LIMIT \\$3 OFFSET \\$4").
+		WithArgs(21, 30, 10, 10).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	stats, err := driver.ReadProposerStats(&types.ReadProposerStatsOptions{FromHeight: 21, ToHeight: 30, PerPage: 10, Page: 2})
+	c.NoError(err)
+	c.Len(stats, 2)
+	c.Equal("A2143929B30CBC3E7A30C2DE06B385BCF874134B", stats[0].Address)
+	c.Equal(3, stats[0].BlocksProposed)
+	c.Equal(0.75, stats[0].Share)
+
+	mock.ExpectQuery("FROM blocks GROUP BY proposer_address").WithArgs(1000, 0).WillReturnError(errors.New("dummy error"))
+
+	stats, err = driver.ReadProposerStats(nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(stats)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
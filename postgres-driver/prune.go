@@ -0,0 +1,121 @@
+package postgresdriver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	defaultPruneBatchSize = 10000
+
+	selectMaxHeightFromTableScript = "SELECT MAX(height) FROM %s"
+	deleteSnapshotsBatchScript     = `
+	DELETE FROM %[1]s WHERE id IN (
+		SELECT id FROM %[1]s WHERE height <= $1 AND ($2 = 0 OR MOD(height, NULLIF($2, 0)) <> 0)
+		LIMIT $3
+	)`
+)
+
+var (
+	// ErrInvalidRetentionEntity error when given retention entity cannot be pruned
+	ErrInvalidRetentionEntity = errors.New("invalid retention entity")
+
+	// prunableTables is the whitelist of tables that can be pruned, blocks and transactions are never pruned
+	prunableTables = map[types.RetentionEntity]string{
+		types.AccountsRetentionEntity: "accounts",
+		types.NodesRetentionEntity:    "nodes",
+		types.AppsRetentionEntity:     "apps",
+	}
+	prunableEntities = []types.RetentionEntity{
+		types.AccountsRetentionEntity,
+		types.NodesRetentionEntity,
+		types.AppsRetentionEntity,
+	}
+)
+
+// Prune deletes the snapshots of accounts, nodes and apps not kept by the given policy
+// deletion runs in batches of policy.BatchSize rows (default 10000), blocks and transactions are never deleted
+// returns quantity of rows deleted
+func (d *PostgresDriver) Prune(ctx context.Context, policy types.RetentionPolicy) (int64, error) {
+	for entity := range policy.Entities {
+		_, ok := prunableTables[entity]
+		if !ok {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidRetentionEntity, entity)
+		}
+	}
+
+	batchSize := policy.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultPruneBatchSize
+	}
+
+	var deleted int64
+
+	for _, entity := range prunableEntities {
+		retention, ok := policy.Entities[entity]
+		if !ok {
+			retention = policy.Default
+		}
+
+		tableDeleted, err := d.pruneTable(ctx, prunableTables[entity], retention, batchSize)
+		deleted += tableDeleted
+		if err != nil {
+			return deleted, err
+		}
+	}
+
+	return deleted, nil
+}
+
+func (d *PostgresDriver) pruneTable(ctx context.Context, table string, retention types.Retention, batchSize int) (int64, error) {
+	if retention.KeepLastHeights <= 0 && retention.KeepEveryHeight <= 0 {
+		return 0, nil
+	}
+
+	var maxHeight sql.NullInt64
+
+	err := d.QueryRowContext(ctx, fmt.Sprintf(selectMaxHeightFromTableScript, table)).Scan(&maxHeight)
+	if err != nil {
+		return 0, err
+	}
+
+	if !maxHeight.Valid {
+		return 0, nil
+	}
+
+	keepLastHeights := retention.KeepLastHeights
+	if keepLastHeights <= 0 {
+		keepLastHeights = 1
+	}
+
+	return d.deleteSnapshots(ctx, table, maxHeight.Int64-int64(keepLastHeights), retention.KeepEveryHeight, batchSize)
+}
+
+// deleteSnapshots deletes in batches the snapshots of table up to cutHeight not multiple of keepEveryHeight
+func (d *PostgresDriver) deleteSnapshots(ctx context.Context, table string, cutHeight int64, keepEveryHeight, batchSize int) (int64, error) {
+	query := fmt.Sprintf(deleteSnapshotsBatchScript, table)
+
+	var deleted int64
+
+	for {
+		result, err := d.ExecContext(ctx, query, cutHeight, keepEveryHeight, batchSize)
+		if err != nil {
+			return deleted, err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return deleted, err
+		}
+
+		deleted += rowsAffected
+
+		if rowsAffected < int64(batchSize) {
+			return deleted, nil
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package postgresdriver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_Prune(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	deleted, err := driver.Prune(context.Background(), types.RetentionPolicy{
+		Entities: map[types.RetentionEntity]types.Retention{"blocks": {KeepLastHeights: 1}},
+	})
+	c.ErrorIs(err, ErrInvalidRetentionEntity)
+	c.Empty(deleted)
+
+	mock.ExpectQuery("^SELECT MAX\\(height\\) FROM accounts").WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(100))
+	mock.ExpectExec("DELETE FROM accounts").WithArgs(90, 5, 2).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM accounts").WithArgs(90, 5, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("^SELECT MAX\\(height\\) FROM apps").WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(nil))
+
+	deleted, err = driver.Prune(context.Background(), types.RetentionPolicy{
+		Default: types.Retention{KeepEveryHeight: 10},
+		Entities: map[types.RetentionEntity]types.Retention{
+			types.AccountsRetentionEntity: {KeepLastHeights: 10, KeepEveryHeight: 5},
+			types.NodesRetentionEntity:    {},
+		},
+		BatchSize: 2,
+	})
+	c.NoError(err)
+	c.Equal(int64(3), deleted)
+
+	mock.ExpectQuery("^SELECT MAX\\(height\\) FROM accounts").WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(100))
+	mock.ExpectExec("DELETE FROM accounts").WithArgs(99, 10, defaultPruneBatchSize).WillReturnResult(sqlmock.NewResult(0, 7))
+	mock.ExpectQuery("^SELECT MAX\\(height\\) FROM nodes").WillReturnError(errors.New("dummy error"))
+
+	deleted, err = driver.Prune(context.Background(), types.RetentionPolicy{
+		Default: types.Retention{KeepEveryHeight: 10},
+	})
+	c.EqualError(err, "dummy error")
+	c.Equal(int64(7), deleted)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,130 @@
+package postgresdriver
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	// selectRelayChainStakesScript unnests the chains of the nodes and apps snapshots of the height,
+	// jailed and unstaking ones are not serving relays and are skipped
+	selectRelayChainStakesScript = `
+	WITH stakes AS (
+		SELECT address, 'node' AS staker_type, chain, tokens AS staked_tokens
+		FROM nodes, unnest(chains) AS chain
+		WHERE height = COALESCE(NULLIF(%[1]s::int, 0), (SELECT MAX(height) FROM nodes)) AND NOT jailed AND unstaking_time IS NULL
+		UNION ALL
+		SELECT address, 'app' AS staker_type, chain, staked_tokens
+		FROM apps, unnest(chains) AS chain
+		WHERE height = COALESCE(NULLIF(%[1]s::int, 0), (SELECT MAX(height) FROM apps)) AND NOT jailed AND unstaking_time IS NULL
+	)`
+	selectRelayChainStatsScript = `%s
+	SELECT chain, COUNT(*) FILTER (WHERE staker_type = 'node') AS nodes_quantity, COUNT(*) FILTER (WHERE staker_type = 'app') AS apps_quantity,
+		COALESCE(SUM(staked_tokens) FILTER (WHERE staker_type = 'node'), 0) AS nodes_staked_tokens,
+		COALESCE(SUM(staked_tokens) FILTER (WHERE staker_type = 'app'), 0) AS apps_staked_tokens
+	FROM stakes GROUP BY chain ORDER BY chain`
+	selectRelayChainStakersScript = `%s
+	SELECT address, staker_type FROM stakes WHERE chain = %s ORDER BY staker_type, address`
+
+	nodeStakerType = "node"
+	appStakerType  = "app"
+)
+
+// dbRelayChainStats is struct handler for the relay chain stats with types needed for Postgres processing
+type dbRelayChainStats struct {
+	Chain             string `db:"chain"`
+	NodesQuantity     int    `db:"nodes_quantity"`
+	AppsQuantity      int    `db:"apps_quantity"`
+	NodesStakedTokens string `db:"nodes_staked_tokens"`
+	AppsStakedTokens  string `db:"apps_staked_tokens"`
+}
+
+func (s *dbRelayChainStats) toIndexerRelayChainStats() *types.RelayChainStats {
+	nodesStakedTokens := new(big.Int)
+	nodesStakedTokens, _ = nodesStakedTokens.SetString(s.NodesStakedTokens, 10)
+
+	appsStakedTokens := new(big.Int)
+	appsStakedTokens, _ = appsStakedTokens.SetString(s.AppsStakedTokens, 10)
+
+	return &types.RelayChainStats{
+		Chain:             s.Chain,
+		NodesQuantity:     s.NodesQuantity,
+		AppsQuantity:      s.AppsQuantity,
+		NodesStakedTokens: nodesStakedTokens,
+		AppsStakedTokens:  appsStakedTokens,
+	}
+}
+
+// dbRelayChainStaker is struct handler for a node or app staked for a relay chain
+type dbRelayChainStaker struct {
+	Address    string `db:"address"`
+	StakerType string `db:"staker_type"`
+}
+
+// getRelayChainStakesQuery returns the stakes common table expression of the nodes and apps saved with given height
+// height 0 is last height
+func getRelayChainStakesQuery(filters *filterQuery, height int) string {
+	return fmt.Sprintf(selectRelayChainStakesScript, filters.addArg(height))
+}
+
+// ReadRelayChainStats returns the quantity and staked tokens of the nodes and apps staked for each relay chain
+// stakes are taken from the nodes and apps saved with the height, jailed and unstaking ones are skipped
+// Optional values defaults: height: 0 (last height)
+func (d *PostgresDriver) ReadRelayChainStats(options *types.ReadRelayChainStatsOptions) ([]*types.RelayChainStats, error) {
+	if options == nil {
+		options = &types.ReadRelayChainStatsOptions{}
+	}
+
+	filters := &filterQuery{}
+	query := fmt.Sprintf(selectRelayChainStatsScript, getRelayChainStakesQuery(filters, options.Height))
+
+	var allStats []*dbRelayChainStats
+
+	err := d.reader().Select(&allStats, query, filters.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexerStats []*types.RelayChainStats
+
+	for _, stats := range allStats {
+		indexerStats = append(indexerStats, stats.toIndexerRelayChainStats())
+	}
+
+	return indexerStats, nil
+}
+
+// ReadRelayChainStakers returns the addresses of the nodes and apps staked for given relay chain
+// stakes are taken from the nodes and apps saved with the height, jailed and unstaking ones are skipped
+// Optional values defaults: height: 0 (last height)
+func (d *PostgresDriver) ReadRelayChainStakers(chain string, options *types.ReadRelayChainStakersOptions) (*types.RelayChainStakers, error) {
+	if options == nil {
+		options = &types.ReadRelayChainStakersOptions{}
+	}
+
+	filters := &filterQuery{}
+	stakesQuery := getRelayChainStakesQuery(filters, options.Height)
+	query := fmt.Sprintf(selectRelayChainStakersScript, stakesQuery, filters.addArg(chain))
+
+	var stakers []*dbRelayChainStaker
+
+	err := d.reader().Select(&stakers, query, filters.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	relayChainStakers := &types.RelayChainStakers{Chain: chain}
+
+	for _, staker := range stakers {
+		switch staker.StakerType {
+		case nodeStakerType:
+			relayChainStakers.NodeAddresses = append(relayChainStakers.NodeAddresses, staker.Address)
+		case appStakerType:
+			relayChainStakers.AppAddresses = append(relayChainStakers.AppAddresses, staker.Address)
+		}
+	}
+
+	return relayChainStakers, nil
+}
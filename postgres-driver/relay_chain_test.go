@@ -0,0 +1,80 @@
+package postgresdriver
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_ReadRelayChainStats(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"chain", "nodes_quantity", "apps_quantity", "nodes_staked_tokens", "apps_staked_tokens"}).
+		AddRow("0001", 2, 1, "30000000000", "5000000000").
+		AddRow("0021", 1, 0, "15000000000", "0")
+
+	mock.ExpectQuery("FROM nodes, unnest\\(chains\\) AS chain\\s+WHERE height = COALESCE\\(NULLIF\\(\\$1::int, 0\\), \\(SELECT MAX\\(height\\) FROM nodes\\)\\) " +
+		"AND NOT jailed AND unstaking_time IS NULL(.+)FROM apps, unnest\\(chains\\) AS chain(.+)GROUP BY chain ORDER BY chain").
+		WithArgs(21).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	stats, err := driver.ReadRelayChainStats(&types.ReadRelayChainStatsOptions{Height: 21})
+	c.NoError(err)
+	c.Len(stats, 2)
+	c.Equal("0001", stats[0].Chain)
+	c.Equal(2, stats[0].NodesQuantity)
+	c.Equal(big.NewInt(30000000000), stats[0].NodesStakedTokens)
+	c.Equal(big.NewInt(0), stats[1].AppsStakedTokens)
+
+	mock.ExpectQuery("GROUP BY chain ORDER BY chain").WithArgs(0).WillReturnError(errors.New("dummy error"))
+
+	stats, err = driver.ReadRelayChainStats(nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(stats)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadRelayChainStakers(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"address", "staker_type"}).
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e21", "app").
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e22", "node").
+		AddRow("00353abd21ef72725b295ba5a9a5eb6082548e23", "node")
+
+	mock.ExpectQuery("FROM stakes WHERE chain = \\$2").WithArgs(21, "0021").WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	stakers, err := driver.ReadRelayChainStakers("0021", &types.ReadRelayChainStakersOptions{Height: 21})
+	c.NoError(err)
+	c.Equal(&types.RelayChainStakers{
+		Chain:         "0021",
+		NodeAddresses: []string{"00353abd21ef72725b295ba5a9a5eb6082548e22", "00353abd21ef72725b295ba5a9a5eb6082548e23"},
+		AppAddresses:  []string{"00353abd21ef72725b295ba5a9a5eb6082548e21"},
+	}, stakers)
+
+	mock.ExpectQuery("FROM stakes WHERE chain = \\$2").WithArgs(0, "0021").WillReturnError(errors.New("dummy error"))
+
+	stakers, err = driver.ReadRelayChainStakers("0021", nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(stakers)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
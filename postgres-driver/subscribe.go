@@ -0,0 +1,129 @@
+package postgresdriver
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	// defaultHeightsChannel is the heights channel of drivers without schema
+	defaultHeightsChannel = "indexed_heights"
+	// maxChannelLength is the max length of a postgres identifier, longer channel names are truncated
+	maxChannelLength = 63
+
+	notifyHeightScript = "SELECT pg_notify($1, $2)"
+
+	minListenerReconnect = 10 * time.Second
+	maxListenerReconnect = time.Minute
+	listenerPingInterval = 90 * time.Second
+)
+
+var (
+	// ErrSubscribeNotSupported error when the driver was not built from a connection string
+	ErrSubscribeNotSupported = errors.New("subscribe needs a driver built from a connection string")
+)
+
+// HeightsChannel returns the channel heights are notified on, indexed_heights_<schema> on drivers with schema
+// so networks sharing a database do not receive the heights of each other
+func (d *PostgresDriver) HeightsChannel() string {
+	if d.schema == "" {
+		return defaultHeightsChannel
+	}
+
+	channel := defaultHeightsChannel + "_" + d.schema
+	if len(channel) > maxChannelLength {
+		return channel[:maxChannelLength]
+	}
+
+	return channel
+}
+
+// NotifyHeight notifies the height on HeightsChannel
+// the indexer calls it once all the values of the height are written, supply and network stats included
+func (d *PostgresDriver) NotifyHeight(height int) error {
+	_, err := d.Exec(notifyHeightScript, d.HeightsChannel(), strconv.Itoa(height))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Subscribe returns a channel receiving an event for each height notified after the call
+// notifications sent while the listener reconnects are lost, so the first event after a reconnection
+// has the max height stored for subscribers to catch up. The channel is closed when ctx is done
+func (d *PostgresDriver) Subscribe(ctx context.Context) (<-chan *types.HeightCommittedEvent, error) {
+	if d.connectionString == "" {
+		return nil, ErrSubscribeNotSupported
+	}
+
+	listener := pq.NewListener(d.connectionString, minListenerReconnect, maxListenerReconnect, nil)
+
+	err := listener.Listen(d.HeightsChannel())
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	events := make(chan *types.HeightCommittedEvent)
+
+	go func() {
+		defer listener.Close()
+
+		d.forwardHeightNotifications(ctx, listener.Notify, listener.Ping, events)
+	}()
+
+	return events, nil
+}
+
+// forwardHeightNotifications sends the events of the notifications until ctx is done, then closes events
+// ping checks the connection when there are no notifications for a while, a failed ping reconnects the listener
+func (d *PostgresDriver) forwardHeightNotifications(ctx context.Context, notifications <-chan *pq.Notification,
+	ping func() error, events chan<- *types.HeightCommittedEvent) {
+	defer close(events)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case notification := <-notifications:
+			event, ok := d.getHeightCommittedEvent(notification)
+			if !ok {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case events <- event:
+			}
+		case <-time.After(listenerPingInterval):
+			_ = ping()
+		}
+	}
+}
+
+// getHeightCommittedEvent returns the event of the notification, false if it has no valid height
+// nil notification is sent after a reconnection and returns the max height on the primary
+func (d *PostgresDriver) getHeightCommittedEvent(notification *pq.Notification) (*types.HeightCommittedEvent, bool) {
+	if notification == nil {
+		maxHeight, err := d.Primary().GetMaxHeightInBlocks()
+		if err != nil {
+			return nil, false
+		}
+
+		return &types.HeightCommittedEvent{Height: int(maxHeight)}, true
+	}
+
+	height, err := strconv.Atoi(notification.Extra)
+	if err != nil {
+		return nil, false
+	}
+
+	return &types.HeightCommittedEvent{Height: height}, true
+}
@@ -0,0 +1,100 @@
+package postgresdriver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_Subscribe(t *testing.T) {
+	c := require.New(t)
+
+	db, _, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	events, err := driver.Subscribe(context.Background())
+	c.Equal(ErrSubscribeNotSupported, err)
+	c.Nil(events)
+}
+
+func TestPostgresDriver_HeightsChannel(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal("indexed_heights", (&PostgresDriver{}).HeightsChannel())
+	c.Equal("indexed_heights_testnet", (&PostgresDriver{schema: "testnet"}).HeightsChannel())
+	c.Equal("indexed_heights_testnet", (&PostgresDriver{schema: "testnet"}).Primary().HeightsChannel())
+	c.Len((&PostgresDriver{schema: strings.Repeat("a", 63)}).HeightsChannel(), 63)
+}
+
+func TestPostgresDriver_NotifyHeight(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+	driver.schema = "testnet"
+
+	mock.ExpectExec("^SELECT pg_notify\\(\\$1, \\$2\\)$").WithArgs("indexed_heights_testnet", "21").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = driver.NotifyHeight(21)
+	c.NoError(err)
+
+	mock.ExpectExec("^SELECT pg_notify").WithArgs("indexed_heights_testnet", "21").WillReturnError(errors.New("dummy error"))
+
+	err = driver.NotifyHeight(21)
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_forwardHeightNotifications(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	mock.ExpectQuery("^SELECT MAX\\(height\\) FROM blocks$").WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(25))
+	mock.ExpectQuery("^SELECT MAX\\(height\\) FROM blocks$").WillReturnError(errors.New("dummy error"))
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	notifications := make(chan *pq.Notification)
+	events := make(chan *types.HeightCommittedEvent)
+
+	go driver.forwardHeightNotifications(ctx, notifications, func() error { return nil }, events)
+
+	notifications <- &pq.Notification{Channel: "indexed_heights", Extra: "21"}
+	c.Equal(&types.HeightCommittedEvent{Height: 21}, <-events)
+
+	notifications <- &pq.Notification{Channel: "indexed_heights", Extra: "dummy"}
+	notifications <- nil
+	c.Equal(&types.HeightCommittedEvent{Height: 25}, <-events)
+
+	notifications <- nil
+	notifications <- &pq.Notification{Channel: "indexed_heights", Extra: "26"}
+	c.Equal(&types.HeightCommittedEvent{Height: 26}, <-events)
+
+	cancel()
+
+	_, ok := <-events
+	c.False(ok)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
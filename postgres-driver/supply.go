@@ -0,0 +1,147 @@
+package postgresdriver
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	// selectSupplyScript counts the liquid supply in upokt only, balance holds the upokt amount of the accounts
+	selectSupplyScript = `
+	SELECT $1::int AS height, accounts.liquid, nodes.nodes_staked, apps.apps_staked, nodes.jailed_staked + apps.jailed_staked AS jailed_staked,
+		nodes.nodes_staked + apps.apps_staked AS staked, accounts.liquid + nodes.nodes_staked + apps.apps_staked AS total
+	FROM (SELECT COALESCE(SUM(balance), 0) AS liquid FROM accounts
+		WHERE height = $1 AND balance_denomination = '` + types.NativeDenomination + `') AS accounts,
+		(SELECT COALESCE(SUM(tokens), 0) AS nodes_staked, COALESCE(SUM(tokens) FILTER (WHERE jailed), 0) AS jailed_staked
+		FROM nodes WHERE height = $1) AS nodes,
+		(SELECT COALESCE(SUM(staked_tokens), 0) AS apps_staked, COALESCE(SUM(staked_tokens) FILTER (WHERE jailed), 0) AS jailed_staked
+		FROM apps WHERE height = $1) AS apps`
+	insertSupplyScript = `
+	INSERT INTO supplies (height, liquid, nodes_staked, apps_staked, jailed_staked, staked, total)
+	VALUES (:height, :liquid, :nodes_staked, :apps_staked, :jailed_staked, :staked, :total)
+	ON CONFLICT (height) DO UPDATE SET
+		liquid = EXCLUDED.liquid, nodes_staked = EXCLUDED.nodes_staked, apps_staked = EXCLUDED.apps_staked,
+		jailed_staked = EXCLUDED.jailed_staked, staked = EXCLUDED.staked, total = EXCLUDED.total`
+	selectSupplyHistoryScript = `
+	SELECT * FROM supplies %s ORDER BY height %s
+	LIMIT %s OFFSET %s`
+)
+
+// dbSupply is struct handler for the supply with types needed for Postgres processing
+type dbSupply struct {
+	Height       int    `db:"height"`
+	Liquid       string `db:"liquid"`
+	NodesStaked  string `db:"nodes_staked"`
+	AppsStaked   string `db:"apps_staked"`
+	JailedStaked string `db:"jailed_staked"`
+	Staked       string `db:"staked"`
+	Total        string `db:"total"`
+}
+
+func (s *dbSupply) toIndexerSupply() *types.Supply {
+	liquid := new(big.Int)
+	liquid, _ = liquid.SetString(s.Liquid, 10)
+
+	nodesStaked := new(big.Int)
+	nodesStaked, _ = nodesStaked.SetString(s.NodesStaked, 10)
+
+	appsStaked := new(big.Int)
+	appsStaked, _ = appsStaked.SetString(s.AppsStaked, 10)
+
+	jailedStaked := new(big.Int)
+	jailedStaked, _ = jailedStaked.SetString(s.JailedStaked, 10)
+
+	staked := new(big.Int)
+	staked, _ = staked.SetString(s.Staked, 10)
+
+	total := new(big.Int)
+	total, _ = total.SetString(s.Total, 10)
+
+	return &types.Supply{
+		Height:       s.Height,
+		Liquid:       liquid,
+		NodesStaked:  nodesStaked,
+		AppsStaked:   appsStaked,
+		JailedStaked: jailedStaked,
+		Staked:       staked,
+		Total:        total,
+	}
+}
+
+func convertIndexerSupplyToDBSupply(indexerSupply *types.Supply) *dbSupply {
+	return &dbSupply{
+		Height:       indexerSupply.Height,
+		Liquid:       indexerSupply.Liquid.String(),
+		NodesStaked:  indexerSupply.NodesStaked.String(),
+		AppsStaked:   indexerSupply.AppsStaked.String(),
+		JailedStaked: indexerSupply.JailedStaked.String(),
+		Staked:       indexerSupply.Staked.String(),
+		Total:        indexerSupply.Total.String(),
+	}
+}
+
+// GetSupply returns the upokt supply computed from the accounts, nodes and apps saved with given height
+func (d *PostgresDriver) GetSupply(height int) (*types.Supply, error) {
+	var supply dbSupply
+
+	err := d.reader().Get(&supply, selectSupplyScript, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return supply.toIndexerSupply(), nil
+}
+
+// WriteSupply inserts given supply to the database, a supply already saved for the height is replaced
+func (d *PostgresDriver) WriteSupply(supply *types.Supply) error {
+	_, err := d.NamedExec(insertSupplyScript, convertIndexerSupplyToDBSupply(supply))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReadSupplyHistory returns the supplies saved in the given heights range with pagination
+// Optional values defaults: page: 1, perPage: 1000, order: desc, range: all heights
+func (d *PostgresDriver) ReadSupplyHistory(options *types.ReadSupplyHistoryOptions) ([]*types.Supply, error) {
+	if options == nil {
+		options = &types.ReadSupplyHistoryOptions{}
+	}
+
+	order, err := getOrderValue(options.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &filterQuery{}
+
+	if options.FromHeight > 0 {
+		filters.addFilter("height >= %s", options.FromHeight)
+	}
+
+	if options.ToHeight > 0 {
+		filters.addFilter("height <= %s", options.ToHeight)
+	}
+
+	perPage := getPerPageValue(options.PerPage)
+	move := getMoveValue(perPage, getPageValue(options.Page))
+	query := fmt.Sprintf(selectSupplyHistoryScript, filters.where(), order, filters.addArg(perPage), filters.addArg(move))
+
+	var supplies []*dbSupply
+
+	err = d.reader().Select(&supplies, query, filters.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexerSupplies []*types.Supply
+
+	for _, supply := range supplies {
+		indexerSupplies = append(indexerSupplies, supply.toIndexerSupply())
+	}
+
+	return indexerSupplies, nil
+}
@@ -0,0 +1,123 @@
+package postgresdriver
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDriver_GetSupply(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"height", "liquid", "nodes_staked", "apps_staked", "jailed_staked", "staked", "total"}).
+		AddRow(21, "1000", "300", "200", "50", "500", "1500")
+
+	mock.ExpectQuery("SUM\\(balance\\), 0\\) AS liquid FROM accounts\\s+WHERE height = \\$1 AND balance_denomination = 'upokt'").
+		WithArgs(21).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	supply, err := driver.GetSupply(21)
+	c.NoError(err)
+	c.Equal(&types.Supply{
+		Height:       21,
+		Liquid:       big.NewInt(1000),
+		NodesStaked:  big.NewInt(300),
+		AppsStaked:   big.NewInt(200),
+		JailedStaked: big.NewInt(50),
+		Staked:       big.NewInt(500),
+		Total:        big.NewInt(1500),
+	}, supply)
+
+	mock.ExpectQuery("FROM accounts(.+)WHERE height = \\$1").WithArgs(21).WillReturnError(errors.New("dummy error"))
+
+	supply, err = driver.GetSupply(21)
+	c.EqualError(err, "dummy error")
+	c.Empty(supply)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_WriteSupply(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	mock.ExpectExec("INSERT INTO supplies").WithArgs(21, "1000", "300", "200", "50", "500", "1500").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	supplyToSend := &types.Supply{
+		Height:       21,
+		Liquid:       big.NewInt(1000),
+		NodesStaked:  big.NewInt(300),
+		AppsStaked:   big.NewInt(200),
+		JailedStaked: big.NewInt(50),
+		Staked:       big.NewInt(500),
+		Total:        big.NewInt(1500),
+	}
+
+	err = driver.WriteSupply(supplyToSend)
+	c.NoError(err)
+
+	mock.ExpectExec("INSERT INTO supplies").WithArgs(21, "1000", "300", "200", "50", "500", "1500").
+		WillReturnError(errors.New("dummy error"))
+
+	err = driver.WriteSupply(supplyToSend)
+	c.EqualError(err, "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadSupplyHistory(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"height", "liquid", "nodes_staked", "apps_staked", "jailed_staked", "staked", "total"}).
+		AddRow(21, "1000", "300", "200", "50", "500", "1500").
+		AddRow(22, "1100", "300", "200", "0", "500", "1600")
+
+	mock.ExpectQuery("^SELECT \\* FROM supplies WHERE height >= \\$1 AND height <= \\$2 ORDER BY height ASC LIMIT \\$3 OFFSET \\$4$").
+		WithArgs(21, 30, 10, 0).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	supplies, err := driver.ReadSupplyHistory(&types.ReadSupplyHistoryOptions{
+		FromHeight: 21,
+		ToHeight:   30,
+		PerPage:    10,
+		Order:      types.AscendantOrder,
+	})
+	c.NoError(err)
+	c.Len(supplies, 2)
+	c.Equal(big.NewInt(1600), supplies[1].Total)
+
+	mock.ExpectQuery("^SELECT \\* FROM supplies ORDER BY height DESC").WithArgs(1000, 0).WillReturnError(errors.New("dummy error"))
+
+	supplies, err = driver.ReadSupplyHistory(nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(supplies)
+
+	supplies, err = driver.ReadSupplyHistory(&types.ReadSupplyHistoryOptions{Order: "sideways"})
+	c.ErrorIs(err, ErrInvalidOrder)
+	c.Empty(supplies)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
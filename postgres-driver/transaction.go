@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"strings"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"github.com/pokt-foundation/pocket-go/utils"
 	"github.com/pokt-foundation/pocket-indexer-lib/types"
@@ -13,40 +14,49 @@ import (
 
 const (
 	insertTransactionsScript = `
-	INSERT into transactions (hash, from_address, to_address, app_pub_key, blockchains, message_type, height, index, stdtx, tx_result, tx, entropy, fee, fee_denomination, amount)
+	INSERT into transactions (hash, from_address, to_address, app_pub_key, blockchains, message_type, height, index, stdtx, tx_result, tx, entropy, fee, fee_denomination, amount, fees, memo, signature, proof_root_hash, signer_address)
 	(
-		select * from unnest($1::text[], $2::text[], $3::text[], $4::text[], $5::text[], $6::text[], $7::int[], $8::int[], $9::jsonb[], $10::jsonb[], $11::text[], $12::numeric[], $13::int[], $14::text[], $15::numeric[])
+		select hash, from_address, to_address, app_pub_key, string_to_array(NULLIF(blockchains, ''), ','), message_type, height, index, stdtx, tx_result, tx, entropy, fee, fee_denomination, amount, fees, memo, signature, proof_root_hash, signer_address
+		from unnest($1::text[], $2::text[], $3::text[], $4::text[], $5::text[], $6::text[], $7::int[], $8::int[], $9::jsonb[], $10::jsonb[], $11::text[], $12::numeric[], $13::numeric[], $14::text[], $15::numeric[], $16::jsonb[], $17::text[], $18::text[], $19::text[], $20::text[])
+		as t(hash, from_address, to_address, app_pub_key, blockchains, message_type, height, index, stdtx, tx_result, tx, entropy, fee, fee_denomination, amount, fees, memo, signature, proof_root_hash, signer_address)
 	)`
 	selectTransactionsScript = `
-	DECLARE transactions_cursor CURSOR FOR SELECT * FROM transactions ORDER BY height %s;
-	MOVE absolute %d from transactions_cursor;
-	FETCH %d FROM transactions_cursor;
-	`
-
+	SELECT * FROM transactions ORDER BY height %s
+	LIMIT $1 OFFSET $2`
 	selectTransactionsByAddressScript = `
-	DECLARE transactions_cursor CURSOR FOR SELECT * FROM transactions WHERE from_address = '%s' OR to_address = '%s' ORDER BY height DESC;
-	MOVE absolute %d from transactions_cursor;
-	FETCH %d FROM transactions_cursor;
-	`
+	SELECT * FROM transactions WHERE %s ORDER BY height DESC
+	LIMIT $2 OFFSET $3`
+	selectTransactionsByBlockchainScript = `
+	SELECT * FROM transactions WHERE blockchains @> ARRAY[$1] ORDER BY height %s
+	LIMIT $2 OFFSET $3`
+	selectTransactionsByMemoScript = `
+	SELECT * FROM transactions WHERE memo = $1 ORDER BY height %s
+	LIMIT $2 OFFSET $3`
+	// selectTransactionsByMemoPrefixScript can use a btree index on memo with text_pattern_ops
+	selectTransactionsByMemoPrefixScript = `
+	SELECT * FROM transactions WHERE memo LIKE $1 || '%%' ESCAPE '\' ORDER BY height %s
+	LIMIT $2 OFFSET $3`
 	selectTransactionByHashScript    = "SELECT * FROM transactions WHERE hash = $1"
 	selectTransactionsByHeightScript = `
-	DECLARE transactions_cursor CURSOR FOR SELECT * FROM transactions WHERE height = '%d';
-	MOVE absolute %d from transactions_cursor;
-	FETCH %d FROM transactions_cursor;
-	`
+	SELECT * FROM transactions WHERE height = $1
+	LIMIT $2 OFFSET $3`
 	selectTransactionsByMaxHeightScript = `
-	DECLARE transactions_cursor CURSOR FOR SELECT * FROM transactions WHERE height = (SELECT MAX(height) FROM transactions);
-	MOVE absolute %d from transactions_cursor;
-	FETCH %d FROM transactions_cursor;
-	`
+	SELECT * FROM transactions WHERE height = (SELECT MAX(height) FROM transactions)
+	LIMIT $1 OFFSET $2`
 	selectCountFromTransactions            = "SELECT COUNT(*) FROM transactions"
-	selectCountFromTransactionsByAddress   = "SELECT COUNT(*) FROM transactions WHERE from_address = $1 OR to_address = $1"
+	selectCountFromTransactionsByAddress   = "SELECT COUNT(*) FROM transactions WHERE %s"
 	selectCountFromTransactionsByHeight    = "SELECT COUNT(*) FROM transactions WHERE height = $1"
 	selectCountFromTransactionsByMaxHeight = "SELECT COUNT(*) FROM transactions WHERE height = (SELECT MAX(height) FROM transactions)"
 
 	chainsSeparator = ","
+
+	addressCondition           = "from_address = $1 OR to_address = $1"
+	addressWithSignerCondition = "from_address = $1 OR to_address = $1 OR signer_address = $1"
 )
 
+// likePatternEscaper escapes the LIKE wildcards of a text to match it literally
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 // dbTransaction is struct handler for the transaction with types needed for Postgres processing
 type dbTransaction struct {
 	ID          int            `db:"id"`
@@ -54,30 +64,39 @@ type dbTransaction struct {
 	FromAddress sql.NullString `db:"from_address"`
 	ToAddress   sql.NullString `db:"to_address"`
 	AppPubKey   string         `db:"app_pub_key"`
-	// It is necessary to save Blockchains as a joined string to be able to use SQL's Unnest with this field
-	Blockchains     string    `db:"blockchains"`
-	MessageType     string    `db:"message_type"`
-	Height          int       `db:"height"`
-	Index           int       `db:"index"`
-	StdTx           *stdTx    `db:"stdtx"`
-	TxResult        *txResult `db:"tx_result"`
-	Tx              string    `db:"tx"`
-	Entropy         int       `db:"entropy"`
-	Fee             int       `db:"fee"`
-	FeeDenomination string    `db:"fee_denomination"`
-	Amount          string    `db:"amount"`
+	// Blockchains are sent as a joined string to be able to use SQL's Unnest with this field
+	// and stored as an array by the insert
+	Blockchains     pq.StringArray `db:"blockchains"`
+	MessageType     string         `db:"message_type"`
+	Height          int            `db:"height"`
+	Index           int            `db:"index"`
+	StdTx           *stdTx         `db:"stdtx"`
+	TxResult        *txResult      `db:"tx_result"`
+	Tx              string         `db:"tx"`
+	Entropy         int            `db:"entropy"`
+	Fee             string         `db:"fee"`
+	FeeDenomination string         `db:"fee_denomination"`
+	Amount          string         `db:"amount"`
+	Fees            coins          `db:"fees"`
+	Memo            sql.NullString `db:"memo"`
+	Signature       sql.NullString `db:"signature"`
+	ProofRootHash   sql.NullString `db:"proof_root_hash"`
+	SignerAddress   sql.NullString `db:"signer_address"`
 }
 
 func (t *dbTransaction) toIndexerTransaction() *types.Transaction {
 	amount := new(big.Int)
 	amount, _ = amount.SetString(t.Amount, 10)
 
+	fee := new(big.Int)
+	fee, _ = fee.SetString(t.Fee, 10)
+
 	return &types.Transaction{
 		Hash:            t.Hash,
 		FromAddress:     t.FromAddress.String,
 		ToAddress:       t.ToAddress.String,
 		AppPubKey:       t.AppPubKey,
-		Blockchains:     strings.Split(t.Blockchains, chainsSeparator),
+		Blockchains:     t.Blockchains,
 		MessageType:     t.MessageType,
 		Height:          t.Height,
 		Index:           t.Index,
@@ -85,9 +104,14 @@ func (t *dbTransaction) toIndexerTransaction() *types.Transaction {
 		TxResult:        t.TxResult.TxResult,
 		Tx:              t.Tx,
 		Entropy:         t.Entropy,
-		Fee:             t.Fee,
+		Fee:             fee,
 		FeeDenomination: t.FeeDenomination,
+		Fees:            t.Fees.toIndexerCoins(),
 		Amount:          amount,
+		Memo:            t.Memo.String,
+		Signature:       t.Signature.String,
+		ProofRootHash:   t.ProofRootHash.String,
+		SignerAddress:   t.SignerAddress.String,
 	}
 }
 
@@ -97,7 +121,7 @@ func convertIndexerTransactionToDBTransaction(indexerTransaction *types.Transact
 		FromAddress:     newSQLNullString(indexerTransaction.FromAddress),
 		ToAddress:       newSQLNullString(indexerTransaction.ToAddress),
 		AppPubKey:       indexerTransaction.AppPubKey,
-		Blockchains:     strings.Join(indexerTransaction.Blockchains, chainsSeparator),
+		Blockchains:     indexerTransaction.Blockchains,
 		MessageType:     indexerTransaction.MessageType,
 		Height:          indexerTransaction.Height,
 		Index:           indexerTransaction.Index,
@@ -105,19 +129,34 @@ func convertIndexerTransactionToDBTransaction(indexerTransaction *types.Transact
 		TxResult:        &txResult{TxResult: indexerTransaction.TxResult},
 		Tx:              indexerTransaction.Tx,
 		Entropy:         indexerTransaction.Entropy,
-		Fee:             indexerTransaction.Fee,
+		Fee:             indexerTransaction.Fee.String(),
 		FeeDenomination: indexerTransaction.FeeDenomination,
+		Fees:            convertIndexerCoinsToDBCoins(indexerTransaction.Fees),
 		Amount:          indexerTransaction.Amount.String(),
+		Memo:            newSQLNullString(indexerTransaction.Memo),
+		Signature:       newSQLNullString(indexerTransaction.Signature),
+		ProofRootHash:   newSQLNullString(indexerTransaction.ProofRootHash),
+		SignerAddress:   newSQLNullString(indexerTransaction.SignerAddress),
 	}
 }
 
 // WriteTransactions inserts given transactions to the database
 func (d *PostgresDriver) WriteTransactions(txs []*types.Transaction) error {
-	var hashes, appPubKeys, blockChains, messageTypes, txStrings, feeDenominations, amounts []string
-	var fromAddresses, toAddresses []sql.NullString
-	var heights, indexes, entropies, fees []int64
+	return writeTransactions(d, txs)
+}
+
+// writeTransactions inserts nothing when there are no transactions
+func writeTransactions(db sqlx.Execer, txs []*types.Transaction) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	var hashes, appPubKeys, blockChains, messageTypes, txStrings, fees, feeDenominations, amounts []string
+	var fromAddresses, toAddresses, memos, signatures, proofRootHashes, signerAddresses []sql.NullString
+	var heights, indexes, entropies []int64
 	var stdTxs []*stdTx
 	var txResults []*txResult
+	var allFees []coins
 
 	for _, tx := range txs {
 		dbTransaction := convertIndexerTransactionToDBTransaction(tx)
@@ -125,7 +164,7 @@ func (d *PostgresDriver) WriteTransactions(txs []*types.Transaction) error {
 		fromAddresses = append(fromAddresses, dbTransaction.FromAddress)
 		toAddresses = append(toAddresses, dbTransaction.ToAddress)
 		appPubKeys = append(appPubKeys, dbTransaction.AppPubKey)
-		blockChains = append(blockChains, dbTransaction.Blockchains)
+		blockChains = append(blockChains, strings.Join(dbTransaction.Blockchains, chainsSeparator))
 		messageTypes = append(messageTypes, dbTransaction.MessageType)
 		heights = append(heights, int64(dbTransaction.Height))
 		indexes = append(indexes, int64(dbTransaction.Index))
@@ -133,12 +172,17 @@ func (d *PostgresDriver) WriteTransactions(txs []*types.Transaction) error {
 		txResults = append(txResults, dbTransaction.TxResult)
 		txStrings = append(txStrings, dbTransaction.Tx)
 		entropies = append(entropies, int64(dbTransaction.Entropy))
-		fees = append(fees, int64(dbTransaction.Fee))
+		fees = append(fees, dbTransaction.Fee)
 		feeDenominations = append(feeDenominations, dbTransaction.FeeDenomination)
 		amounts = append(amounts, dbTransaction.Amount)
+		allFees = append(allFees, dbTransaction.Fees)
+		memos = append(memos, dbTransaction.Memo)
+		signatures = append(signatures, dbTransaction.Signature)
+		proofRootHashes = append(proofRootHashes, dbTransaction.ProofRootHash)
+		signerAddresses = append(signerAddresses, dbTransaction.SignerAddress)
 	}
 
-	_, err := d.Exec(insertTransactionsScript,
+	_, err := db.Exec(insertTransactionsScript,
 		pq.StringArray(hashes),
 		pq.Array(fromAddresses),
 		pq.Array(toAddresses),
@@ -151,9 +195,14 @@ func (d *PostgresDriver) WriteTransactions(txs []*types.Transaction) error {
 		pq.Array(txResults),
 		pq.StringArray(txStrings),
 		pq.Int64Array(entropies),
-		pq.Int64Array(fees),
+		pq.StringArray(fees),
 		pq.StringArray(feeDenominations),
-		pq.StringArray(amounts))
+		pq.StringArray(amounts),
+		pq.Array(allFees),
+		pq.Array(memos),
+		pq.Array(signatures),
+		pq.Array(proofRootHashes),
+		pq.Array(signerAddresses))
 	if err != nil {
 		return err
 	}
@@ -166,31 +215,26 @@ func (d *PostgresDriver) WriteTransactions(txs []*types.Transaction) error {
 func (d *PostgresDriver) ReadTransactions(options *types.ReadTransactionsOptions) ([]*types.Transaction, error) {
 	perPage := defaultPerPage
 	page := defaultPage
-	order := defaultOrder
+	var optionsOrder types.Order
 
 	if options != nil {
 		perPage = getPerPageValue(options.PerPage)
 		page = getPageValue(options.Page)
-		order = getOrderValue(options.Order)
+		optionsOrder = options.Order
 	}
 
-	move := getMoveValue(perPage, page)
-
-	tx, err := d.Beginx()
+	order, err := getOrderValue(optionsOrder)
 	if err != nil {
 		return nil, err
 	}
 
-	query := fmt.Sprintf(selectTransactionsScript, order, move, perPage)
+	move := getMoveValue(perPage, page)
 
-	var transactions []*dbTransaction
+	query := fmt.Sprintf(selectTransactionsScript, order)
 
-	err = tx.Select(&transactions, query)
-	if err != nil {
-		return nil, err
-	}
+	var transactions []*dbTransaction
 
-	err = tx.Commit()
+	err = d.reader().Select(&transactions, query, perPage, move)
 	if err != nil {
 		return nil, err
 	}
@@ -204,38 +248,106 @@ func (d *PostgresDriver) ReadTransactions(options *types.ReadTransactionsOptions
 	return indexerTransactions, nil
 }
 
-// ReadTransactionsByAddress returns transactions with given from address
-// Optional values defaults: page: 1, perPage: 1000
+// getAddressCondition returns the condition matching the transactions of the address in $1
+func getAddressCondition(includeSigner bool) string {
+	if includeSigner {
+		return addressWithSignerCondition
+	}
+
+	return addressCondition
+}
+
+// ReadTransactionsByAddress returns transactions with given from or to address
+// with options.IncludeSigner set also returns transactions signed by the address
+// Optional values defaults: page: 1, perPage: 1000, includeSigner: false
 func (d *PostgresDriver) ReadTransactionsByAddress(address string, options *types.ReadTransactionsByAddressOptions) ([]*types.Transaction, error) {
 	if !utils.ValidateAddress(address) {
 		return nil, ErrInvalidAddress
 	}
 
-	perPage := defaultPerPage
-	page := defaultPage
+	if options == nil {
+		options = &types.ReadTransactionsByAddressOptions{}
+	}
 
-	if options != nil {
-		perPage = getPerPageValue(options.PerPage)
-		page = getPageValue(options.Page)
+	perPage := getPerPageValue(options.PerPage)
+	move := getMoveValue(perPage, getPageValue(options.Page))
+	query := fmt.Sprintf(selectTransactionsByAddressScript, getAddressCondition(options.IncludeSigner))
+
+	var transactions []*dbTransaction
+
+	err := d.reader().Select(&transactions, query, address, perPage, move)
+	if err != nil {
+		return nil, err
 	}
 
-	move := getMoveValue(perPage, page)
+	var indexerTransactions []*types.Transaction
+
+	for _, dbTransaction := range transactions {
+		indexerTransactions = append(indexerTransactions, dbTransaction.toIndexerTransaction())
+	}
 
-	tx, err := d.Beginx()
+	return indexerTransactions, nil
+}
+
+// ReadTransactionsByBlockchain returns transactions referencing given relay chain
+// Optional values defaults: page: 1, perPage: 1000, order: desc
+func (d *PostgresDriver) ReadTransactionsByBlockchain(blockchain string, options *types.ReadTransactionsByBlockchainOptions) ([]*types.Transaction, error) {
+	if options == nil {
+		options = &types.ReadTransactionsByBlockchainOptions{}
+	}
+
+	order, err := getOrderValue(options.Order)
 	if err != nil {
 		return nil, err
 	}
 
-	query := fmt.Sprintf(selectTransactionsByAddressScript, address, address, move, perPage)
+	perPage := getPerPageValue(options.PerPage)
+	move := getMoveValue(perPage, getPageValue(options.Page))
+
+	query := fmt.Sprintf(selectTransactionsByBlockchainScript, order)
 
 	var transactions []*dbTransaction
 
-	err = tx.Select(&transactions, query)
+	err = d.reader().Select(&transactions, query, blockchain, perPage, move)
 	if err != nil {
 		return nil, err
 	}
 
-	err = tx.Commit()
+	var indexerTransactions []*types.Transaction
+
+	for _, dbTransaction := range transactions {
+		indexerTransactions = append(indexerTransactions, dbTransaction.toIndexerTransaction())
+	}
+
+	return indexerTransactions, nil
+}
+
+// ReadTransactionsByMemo returns transactions with given memo
+// with options.Prefix set returns transactions whose memo starts with given text
+// Optional values defaults: page: 1, perPage: 1000, order: desc, prefix: false
+func (d *PostgresDriver) ReadTransactionsByMemo(memo string, options *types.ReadTransactionsByMemoOptions) ([]*types.Transaction, error) {
+	if options == nil {
+		options = &types.ReadTransactionsByMemoOptions{}
+	}
+
+	order, err := getOrderValue(options.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	perPage := getPerPageValue(options.PerPage)
+	move := getMoveValue(perPage, getPageValue(options.Page))
+
+	script := selectTransactionsByMemoScript
+
+	if options.Prefix {
+		script = selectTransactionsByMemoPrefixScript
+		memo = likePatternEscaper.Replace(memo)
+	}
+
+	var transactions []*dbTransaction
+
+	err = d.reader().Select(&transactions, fmt.Sprintf(script, order), memo, perPage, move)
 	if err != nil {
 		return nil, err
 	}
@@ -263,22 +375,12 @@ func (d *PostgresDriver) ReadTransactionsByHeight(height int, options *types.Rea
 
 	move := getMoveValue(perPage, page)
 
-	tx, err := d.Beginx()
-	if err != nil {
-		return nil, err
-	}
-
-	query := getHeightOptionalQuery(selectTransactionsByHeightScript, selectTransactionsByMaxHeightScript,
+	query, args := getHeightOptionalQuery(selectTransactionsByHeightScript, selectTransactionsByMaxHeightScript,
 		height, move, perPage)
 
 	var transactions []*dbTransaction
 
-	err = tx.Select(&transactions, query)
-	if err != nil {
-		return nil, err
-	}
-
-	err = tx.Commit()
+	err := d.reader().Select(&transactions, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -296,7 +398,7 @@ func (d *PostgresDriver) ReadTransactionsByHeight(height int, options *types.Rea
 func (d *PostgresDriver) ReadTransactionByHash(hash string) (*types.Transaction, error) {
 	var dbTransaction dbTransaction
 
-	err := d.Get(&dbTransaction, selectTransactionByHashScript, hash)
+	err := d.reader().Get(&dbTransaction, selectTransactionByHashScript, hash)
 	if err != nil {
 		return nil, err
 	}
@@ -306,7 +408,7 @@ func (d *PostgresDriver) ReadTransactionByHash(hash string) (*types.Transaction,
 
 // GetTransactionsQuantity returns quantity of transactions saved
 func (d *PostgresDriver) GetTransactionsQuantity() (int64, error) {
-	row := d.QueryRow(selectCountFromTransactions)
+	row := d.reader().QueryRow(selectCountFromTransactions)
 
 	var quantity int64
 
@@ -318,13 +420,27 @@ func (d *PostgresDriver) GetTransactionsQuantity() (int64, error) {
 	return quantity, nil
 }
 
-// GetTransactionsQuantityByAddress returns quantity of transactions with given address saved
+// GetTransactionsQuantityByAddress returns quantity of transactions with given from or to address saved
 func (d *PostgresDriver) GetTransactionsQuantityByAddress(address string) (int64, error) {
+	return d.GetTransactionsQuantityByAddressWithOptions(address, nil)
+}
+
+// GetTransactionsQuantityByAddressWithOptions returns quantity of transactions with given from or to address saved
+// with options.IncludeSigner set also counts transactions signed by the address
+func (d *PostgresDriver) GetTransactionsQuantityByAddressWithOptions(address string,
+	options *types.GetTransactionsQuantityByAddressOptions) (int64, error) {
 	if !utils.ValidateAddress(address) {
 		return 0, ErrInvalidAddress
 	}
 
-	row := d.QueryRow(selectCountFromTransactionsByAddress, address)
+	var includeSigner bool
+
+	if options != nil {
+		includeSigner = options.IncludeSigner
+	}
+
+	query := fmt.Sprintf(selectCountFromTransactionsByAddress, getAddressCondition(includeSigner))
+	row := d.reader().QueryRow(query, address)
 
 	var quantity int64
 
@@ -40,8 +40,10 @@ func TestPostgresDriver_WriteTransactions(t *testing.T) {
 				},
 			},
 		},
+		Memo: "deposit 2121",
 		Signature: &provider.TxSignature{
-			PubKey: "adasdsfd",
+			PubKey:    "adasdsfd",
+			Signature: "c2lnbmF0dXJl",
 		},
 	}
 
@@ -55,15 +57,19 @@ func TestPostgresDriver_WriteTransactions(t *testing.T) {
 	mock.ExpectExec("INSERT into transactions").WithArgs(pq.StringArray([]string{"AF5BB3EAFF431E2E5E784D639825979FF20A779725BFE61D4521340F70C3996D0"}),
 		pq.StringArray([]string{"addssd"}), pq.Array([]sql.NullString{{}}), pq.StringArray([]string{"adasdsfd"}), pq.StringArray([]string{"0021"}),
 		pq.StringArray([]string{"pos/Send"}), pq.Int64Array([]int64{0}), pq.Int64Array([]int64{0}), pq.Array([]driver.Value{encodedTestStdTx}),
-		pq.Array([]driver.Value{"{}"}), pq.StringArray([]string{""}), pq.Int64Array([]int64{3223323}), pq.Int64Array([]int64{10000}),
-		pq.StringArray([]string{"upokt"}), pq.StringArray([]string{"462000000"})).
+		pq.Array([]driver.Value{"{}"}), pq.StringArray([]string{""}), pq.Int64Array([]int64{3223323}), pq.StringArray([]string{"10000"}),
+		pq.StringArray([]string{"upokt"}), pq.StringArray([]string{"462000000"}), pq.Array([]driver.Value{`[{"amount":"10000","denom":"upokt"}]`}),
+		pq.Array([]sql.NullString{{String: "deposit 2121", Valid: true}}), pq.Array([]sql.NullString{{String: "c2lnbmF0dXJl", Valid: true}}),
+		pq.Array([]sql.NullString{{}}), pq.Array([]sql.NullString{{String: "1f32488b1db60fe528ab21e3cc26c96696be3faa", Valid: true}})).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	mock.ExpectExec("INSERT into transactions").WithArgs(pq.StringArray([]string{"AF5BB3EAFF431E2E5E784D639825979FF20A779725BFE61D4521340F70C3996D0"}),
 		pq.StringArray([]string{"addssd"}), pq.Array([]sql.NullString{{}}), pq.StringArray([]string{"adasdsfd"}), pq.StringArray([]string{"0021"}),
 		pq.StringArray([]string{"pos/Send"}), pq.Int64Array([]int64{0}), pq.Int64Array([]int64{0}), pq.Array([]driver.Value{encodedTestStdTx}),
-		pq.Array([]driver.Value{"{}"}), pq.StringArray([]string{""}), pq.Int64Array([]int64{3223323}), pq.Int64Array([]int64{10000}),
-		pq.StringArray([]string{"upokt"}), pq.StringArray([]string{"462000000"})).
+		pq.Array([]driver.Value{"{}"}), pq.StringArray([]string{""}), pq.Int64Array([]int64{3223323}), pq.StringArray([]string{"10000"}),
+		pq.StringArray([]string{"upokt"}), pq.StringArray([]string{"462000000"}), pq.Array([]driver.Value{`[{"amount":"10000","denom":"upokt"}]`}),
+		pq.Array([]sql.NullString{{String: "deposit 2121", Valid: true}}), pq.Array([]sql.NullString{{String: "c2lnbmF0dXJl", Valid: true}}),
+		pq.Array([]sql.NullString{{}}), pq.Array([]sql.NullString{{String: "1f32488b1db60fe528ab21e3cc26c96696be3faa", Valid: true}})).
 		WillReturnError(errors.New("dummy error"))
 
 	driver := NewPostgresDriverFromSQLDBInstance(db)
@@ -77,10 +83,14 @@ func TestPostgresDriver_WriteTransactions(t *testing.T) {
 			Blockchains:     []string{"0021"},
 			MessageType:     "pos/Send",
 			Entropy:         3223323,
-			Fee:             10000,
+			Fee:             big.NewInt(10000),
 			FeeDenomination: "upokt",
+			Fees:            []*types.Coin{{Denomination: "upokt", Amount: big.NewInt(10000)}},
 			Amount:          big.NewInt(462000000),
 			StdTx:           testProvStdTx,
+			Memo:            "deposit 2121",
+			Signature:       "c2lnbmF0dXJl",
+			SignerAddress:   "1f32488b1db60fe528ab21e3cc26c96696be3faa",
 		},
 	}
 
@@ -117,9 +127,7 @@ func TestPostgresDriver_ReadTransactions(t *testing.T) {
 		AddRow(1, "ABCD", "abcd", "dbcv", encodedTestStdTx, encodedTxResult).
 		AddRow(2, "ABFD", "abfd", "fbcv", encodedTestStdTx, encodedTxResult)
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnRows(rows)
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM transactions ORDER BY height DESC").WithArgs(3, 3).WillReturnRows(rows)
 
 	driver := NewPostgresDriverFromSQLDBInstance(db)
 
@@ -127,13 +135,26 @@ func TestPostgresDriver_ReadTransactions(t *testing.T) {
 	c.NoError(err)
 	c.Len(transactions, 2)
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnError(errors.New("dummy error"))
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM transactions ORDER BY height DESC").WithArgs(1000, 0).WillReturnError(errors.New("dummy error"))
 
 	transactions, err = driver.ReadTransactions(nil)
 	c.EqualError(err, "dummy error")
 	c.Empty(transactions)
+
+	rows = sqlmock.NewRows([]string{"id", "hash", "from_address", "to_address", "stdtx", "tx_result"}).
+		AddRow(1, "ABCD", "abcd", "dbcv", encodedTestStdTx, encodedTxResult)
+
+	mock.ExpectQuery("^SELECT (.+) FROM transactions ORDER BY height ASC").WithArgs(1000, 0).WillReturnRows(rows)
+
+	transactions, err = driver.ReadTransactions(&types.ReadTransactionsOptions{Order: types.AscendantOrder})
+	c.NoError(err)
+	c.Len(transactions, 1)
+
+	transactions, err = driver.ReadTransactions(&types.ReadTransactionsOptions{Order: "random"})
+	c.ErrorIs(err, ErrInvalidOrder)
+	c.Empty(transactions)
+
+	c.NoError(mock.ExpectationsWereMet())
 }
 
 func TestPostgresDriver_ReadTransactionsByAddress(t *testing.T) {
@@ -162,9 +183,7 @@ func TestPostgresDriver_ReadTransactionsByAddress(t *testing.T) {
 		AddRow(1, "ABCD", "1f32488b1db60fe528ab21e3cc26c96696be3faa", "dbcv", encodedTestStdTx, encodedTxResult).
 		AddRow(2, "ABFD", "1f32488b1db60fe528ab21e3cc26c96696be3faa", "fbcv", encodedTestStdTx, encodedTxResult)
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnRows(rows)
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM transactions WHERE from_address = \\$1").WithArgs("1f32488b1db60fe528ab21e3cc26c96696be3faa", 3, 3).WillReturnRows(rows)
 
 	driver := NewPostgresDriverFromSQLDBInstance(db)
 
@@ -176,13 +195,146 @@ func TestPostgresDriver_ReadTransactionsByAddress(t *testing.T) {
 	c.NoError(err)
 	c.Len(transactions, 2)
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnError(errors.New("dummy error"))
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM transactions WHERE from_address = \\$1").WithArgs("1f32488b1db60fe528ab21e3cc26c96696be3faa", 1000, 0).WillReturnError(errors.New("dummy error"))
 
 	transactions, err = driver.ReadTransactionsByAddress("1f32488b1db60fe528ab21e3cc26c96696be3faa", nil)
 	c.EqualError(err, "dummy error")
 	c.Empty(transactions)
+
+	rows = sqlmock.NewRows([]string{"id", "hash", "signer_address", "stdtx", "tx_result"}).
+		AddRow(1, "ABCD", "1f32488b1db60fe528ab21e3cc26c96696be3faa", encodedTestStdTx, encodedTxResult)
+
+	mock.ExpectQuery("^SELECT (.+) FROM transactions WHERE from_address = \\$1 OR to_address = \\$1 OR signer_address = \\$1 ORDER BY").
+		WithArgs("1f32488b1db60fe528ab21e3cc26c96696be3faa", 1000, 0).WillReturnRows(rows)
+
+	transactions, err = driver.ReadTransactionsByAddress("1f32488b1db60fe528ab21e3cc26c96696be3faa", &types.ReadTransactionsByAddressOptions{IncludeSigner: true})
+	c.NoError(err)
+	c.Len(transactions, 1)
+	c.Equal("1f32488b1db60fe528ab21e3cc26c96696be3faa", transactions[0].SignerAddress)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadTransactionsByBlockchain(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	testStdTx := &stdTx{
+		StdTx: &provider.StdTx{},
+	}
+
+	encodedTestStdTx, err := testStdTx.Value()
+	c.NoError(err)
+
+	testTxResult := &txResult{
+		TxResult: &provider.TxResult{},
+	}
+
+	encodedTxResult, err := testTxResult.Value()
+	c.NoError(err)
+
+	rows := sqlmock.NewRows([]string{"id", "hash", "blockchains", "stdtx", "tx_result"}).
+		AddRow(1, "ABCD", "{0001,0021}", encodedTestStdTx, encodedTxResult).
+		AddRow(2, "ABFD", "{0021}", encodedTestStdTx, encodedTxResult)
+
+	mock.ExpectQuery("^SELECT (.+) FROM transactions WHERE blockchains @> ARRAY\\[\\$1\\] ORDER BY height ASC").
+		WithArgs("0021", 3, 3).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	transactions, err := driver.ReadTransactionsByBlockchain("0021", &types.ReadTransactionsByBlockchainOptions{
+		Page:    2,
+		PerPage: 3,
+		Order:   types.AscendantOrder,
+	})
+	c.NoError(err)
+	c.Len(transactions, 2)
+	c.Equal([]string{"0001", "0021"}, transactions[0].Blockchains)
+
+	mock.ExpectQuery("^SELECT (.+) FROM transactions WHERE blockchains @> ARRAY\\[\\$1\\] ORDER BY height DESC").
+		WithArgs("0021", 1000, 0).WillReturnError(errors.New("dummy error"))
+
+	transactions, err = driver.ReadTransactionsByBlockchain("0021", nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(transactions)
+
+	transactions, err = driver.ReadTransactionsByBlockchain("0021", &types.ReadTransactionsByBlockchainOptions{Order: "; DROP TABLE transactions"})
+	c.ErrorIs(err, ErrInvalidOrder)
+	c.Empty(transactions)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadTransactionsByMemo(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	testStdTx := &stdTx{
+		StdTx: &provider.StdTx{},
+	}
+
+	encodedTestStdTx, err := testStdTx.Value()
+	c.NoError(err)
+
+	testTxResult := &txResult{
+		TxResult: &provider.TxResult{},
+	}
+
+	encodedTxResult, err := testTxResult.Value()
+	c.NoError(err)
+
+	rows := sqlmock.NewRows([]string{"id", "hash", "stdtx", "tx_result", "memo", "signature", "proof_root_hash"}).
+		AddRow(1, "ABCD", encodedTestStdTx, encodedTxResult, "deposit 2121", "c2lnbmF0dXJl", "6A7C1E").
+		AddRow(2, "ABFD", encodedTestStdTx, encodedTxResult, "deposit 2121", nil, nil)
+
+	mock.ExpectQuery("^SELECT (.+) FROM transactions WHERE memo = \\$1 ORDER BY height DESC").
+		WithArgs("deposit 2121", 1000, 0).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	transactions, err := driver.ReadTransactionsByMemo("deposit 2121", nil)
+	c.NoError(err)
+	c.Len(transactions, 2)
+	c.Equal("deposit 2121", transactions[0].Memo)
+	c.Equal("c2lnbmF0dXJl", transactions[0].Signature)
+	c.Equal("6A7C1E", transactions[0].ProofRootHash)
+	c.Empty(transactions[1].Signature)
+
+	rows = sqlmock.NewRows([]string{"id", "hash", "stdtx", "tx_result", "memo"}).
+		AddRow(1, "ABCD", encodedTestStdTx, encodedTxResult, "100%_off deposit")
+
+	mock.ExpectQuery("^SELECT (.+) FROM transactions WHERE memo LIKE \\$1 \\|\\| '%' ESCAPE (.+) ORDER BY height ASC").
+		WithArgs("100\\%\\_off", 3, 3).WillReturnRows(rows)
+
+	transactions, err = driver.ReadTransactionsByMemo("100%_off", &types.ReadTransactionsByMemoOptions{
+		Page:    2,
+		PerPage: 3,
+		Order:   types.AscendantOrder,
+		Prefix:  true,
+	})
+	c.NoError(err)
+	c.Len(transactions, 1)
+
+	mock.ExpectQuery("^SELECT (.+) FROM transactions WHERE memo = \\$1").
+		WithArgs("deposit 2121", 1000, 0).WillReturnError(errors.New("dummy error"))
+
+	transactions, err = driver.ReadTransactionsByMemo("deposit 2121", &types.ReadTransactionsByMemoOptions{})
+	c.EqualError(err, "dummy error")
+	c.Empty(transactions)
+
+	transactions, err = driver.ReadTransactionsByMemo("deposit 2121", &types.ReadTransactionsByMemoOptions{Order: "; DROP TABLE transactions"})
+	c.ErrorIs(err, ErrInvalidOrder)
+	c.Empty(transactions)
+
+	c.NoError(mock.ExpectationsWereMet())
 }
 
 func TestPostgresDriver_ReadTransactionsByHeight(t *testing.T) {
@@ -211,9 +363,7 @@ func TestPostgresDriver_ReadTransactionsByHeight(t *testing.T) {
 		AddRow(1, "ABCD", "1f32488b1db60fe528ab21e3cc26c96696be3faa", "dbcv", 21, encodedTestStdTx, encodedTxResult).
 		AddRow(2, "ABFD", "1f32488b1db60fe528ab21e3cc26c96696be3faa", "fbcv", 21, encodedTestStdTx, encodedTxResult)
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnRows(rows)
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM transactions WHERE height = \\$1").WithArgs(21, 3, 3).WillReturnRows(rows)
 
 	driver := NewPostgresDriverFromSQLDBInstance(db)
 
@@ -221,9 +371,7 @@ func TestPostgresDriver_ReadTransactionsByHeight(t *testing.T) {
 	c.NoError(err)
 	c.Len(transactions, 2)
 
-	mock.ExpectBegin()
-	mock.ExpectQuery(".*").WillReturnError(errors.New("dummy error"))
-	mock.ExpectCommit()
+	mock.ExpectQuery("^SELECT (.+) FROM transactions WHERE height = \\$1").WithArgs(21, 1000, 0).WillReturnError(errors.New("dummy error"))
 
 	transactions, err = driver.ReadTransactionsByHeight(21, nil)
 	c.EqualError(err, "dummy error")
@@ -305,7 +453,7 @@ func TestPostgresDriver_GetTransactionsQuantityByAddress(t *testing.T) {
 
 	rows := sqlmock.NewRows([]string{"count"}).AddRow(100)
 
-	mock.ExpectQuery("^SELECT (.+) FROM transactions").WillReturnRows(rows)
+	mock.ExpectQuery("^SELECT (.+) FROM transactions WHERE from_address = \\$1 OR to_address = \\$1$").WillReturnRows(rows)
 
 	driver := NewPostgresDriverFromSQLDBInstance(db)
 
@@ -313,6 +461,15 @@ func TestPostgresDriver_GetTransactionsQuantityByAddress(t *testing.T) {
 	c.NoError(err)
 	c.Equal(int64(100), maxHeight)
 
+	rows = sqlmock.NewRows([]string{"count"}).AddRow(121)
+
+	mock.ExpectQuery("^SELECT (.+) FROM transactions WHERE (.+) OR signer_address = \\$1$").WillReturnRows(rows)
+
+	maxHeight, err = driver.GetTransactionsQuantityByAddressWithOptions("1f32488b1db60fe528ab21e3cc26c96696be3faa",
+		&types.GetTransactionsQuantityByAddressOptions{IncludeSigner: true})
+	c.NoError(err)
+	c.Equal(int64(121), maxHeight)
+
 	maxHeight, err = driver.GetTransactionsQuantityByAddress("1f32488b1db60fe528ab21e3cc26c96696be3fa")
 	c.Equal(ErrInvalidAddress, err)
 	c.Empty(maxHeight)
@@ -0,0 +1,209 @@
+package postgresdriver
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+)
+
+const (
+	insertWebhookDeliveriesScript = `
+	INSERT into webhook_deliveries (event_type, height, payload, status, attempts, next_attempt_at, created_at)
+	(
+		select event_type, height, payload, 'pending', 0, now(), now()
+		from unnest($1::text[], $2::int[], $3::jsonb[])
+		as t(event_type, height, payload)
+	)`
+	// claimWebhookDeliveriesScript leases the due pending deliveries by moving their next attempt
+	// so concurrent dispatchers skip them and a crashed dispatcher's deliveries are retried when the lease ends
+	claimWebhookDeliveriesScript = `
+	UPDATE webhook_deliveries SET attempts = attempts + 1, next_attempt_at = now() + $2 * interval '1 millisecond'
+	WHERE id IN (
+		SELECT id FROM webhook_deliveries WHERE status = 'pending' AND next_attempt_at <= now()
+		ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED
+	)
+	RETURNING *`
+	updateWebhookDeliveryScript = `
+	UPDATE webhook_deliveries SET status = :status, next_attempt_at = :next_attempt_at, last_error = :last_error,
+	delivered_at = :delivered_at WHERE id = :id`
+	requeueWebhookDeliveryScript = `
+	UPDATE webhook_deliveries SET status = 'pending', attempts = 0, next_attempt_at = now(), last_error = NULL
+	WHERE id = $1 AND status = 'dead'`
+	selectWebhookDeliveriesScript = `
+	SELECT * FROM webhook_deliveries %s ORDER BY id DESC
+	LIMIT %s OFFSET %s`
+)
+
+var (
+	// ErrNoDeadWebhookDelivery error when there is no dead webhook delivery with given ID
+	ErrNoDeadWebhookDelivery = errors.New("no dead webhook delivery with given ID")
+)
+
+// dbWebhookDelivery is struct handler for the webhook delivery with types needed for Postgres processing
+type dbWebhookDelivery struct {
+	ID            int            `db:"id"`
+	EventType     string         `db:"event_type"`
+	Height        int            `db:"height"`
+	Payload       []byte         `db:"payload"`
+	Status        string         `db:"status"`
+	Attempts      int            `db:"attempts"`
+	NextAttemptAt time.Time      `db:"next_attempt_at"`
+	LastError     sql.NullString `db:"last_error"`
+	CreatedAt     time.Time      `db:"created_at"`
+	DeliveredAt   sql.NullTime   `db:"delivered_at"`
+}
+
+func (w *dbWebhookDelivery) toIndexerWebhookDelivery() *types.WebhookDelivery {
+	return &types.WebhookDelivery{
+		ID:            w.ID,
+		EventType:     types.WebhookEventType(w.EventType),
+		Height:        w.Height,
+		Payload:       w.Payload,
+		Status:        types.WebhookDeliveryStatus(w.Status),
+		Attempts:      w.Attempts,
+		NextAttemptAt: w.NextAttemptAt,
+		LastError:     w.LastError.String,
+		CreatedAt:     w.CreatedAt,
+		DeliveredAt:   w.DeliveredAt.Time,
+	}
+}
+
+func convertIndexerWebhookDeliveryToDBWebhookDelivery(indexerDelivery *types.WebhookDelivery) *dbWebhookDelivery {
+	return &dbWebhookDelivery{
+		ID:            indexerDelivery.ID,
+		EventType:     string(indexerDelivery.EventType),
+		Height:        indexerDelivery.Height,
+		Payload:       indexerDelivery.Payload,
+		Status:        string(indexerDelivery.Status),
+		Attempts:      indexerDelivery.Attempts,
+		NextAttemptAt: indexerDelivery.NextAttemptAt,
+		LastError:     newSQLNullString(indexerDelivery.LastError),
+		CreatedAt:     indexerDelivery.CreatedAt,
+		DeliveredAt:   newSQLNullTime(indexerDelivery.DeliveredAt),
+	}
+}
+
+// EnqueueWebhookDeliveries inserts given deliveries to the outbox as pending
+// deliveries are inserted in tx so they are committed with the values they describe, nil tx uses the connection pool
+func (d *PostgresDriver) EnqueueWebhookDeliveries(tx *sql.Tx, deliveries []*types.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	var db sqlx.Execer = d
+
+	if tx != nil {
+		db = tx
+	}
+
+	var eventTypes, payloads []string
+	var heights []int64
+
+	for _, delivery := range deliveries {
+		eventTypes = append(eventTypes, string(delivery.EventType))
+		heights = append(heights, int64(delivery.Height))
+		payloads = append(payloads, string(delivery.Payload))
+	}
+
+	_, err := db.Exec(insertWebhookDeliveriesScript,
+		pq.StringArray(eventTypes),
+		pq.Int64Array(heights),
+		pq.StringArray(payloads))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ClaimWebhookDeliveries returns up to limit pending deliveries due to be sent, oldest first
+// claimed deliveries count an attempt and are not claimed again until the lease ends
+func (d *PostgresDriver) ClaimWebhookDeliveries(limit int, lease time.Duration) ([]*types.WebhookDelivery, error) {
+	var deliveries []*dbWebhookDelivery
+
+	err := d.Select(&deliveries, claimWebhookDeliveriesScript, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].ID < deliveries[j].ID
+	})
+
+	var indexerDeliveries []*types.WebhookDelivery
+
+	for _, delivery := range deliveries {
+		indexerDeliveries = append(indexerDeliveries, delivery.toIndexerWebhookDelivery())
+	}
+
+	return indexerDeliveries, nil
+}
+
+// UpdateWebhookDelivery saves the status, next attempt, last error and delivery time of given delivery
+func (d *PostgresDriver) UpdateWebhookDelivery(delivery *types.WebhookDelivery) error {
+	_, err := d.NamedExec(updateWebhookDeliveryScript, convertIndexerWebhookDeliveryToDBWebhookDelivery(delivery))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RequeueWebhookDelivery moves the dead delivery with given ID back to pending with its attempts reset
+// returns ErrNoDeadWebhookDelivery if there is no dead delivery with the ID
+func (d *PostgresDriver) RequeueWebhookDelivery(id int) error {
+	result, err := d.Exec(requeueWebhookDeliveryScript, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrNoDeadWebhookDelivery
+	}
+
+	return nil
+}
+
+// ReadWebhookDeliveries returns the outbox deliveries, newest first
+// Optional values defaults: page: 1, perPage: 1000, status: all
+func (d *PostgresDriver) ReadWebhookDeliveries(options *types.ReadWebhookDeliveriesOptions) ([]*types.WebhookDelivery, error) {
+	if options == nil {
+		options = &types.ReadWebhookDeliveriesOptions{}
+	}
+
+	filters := &filterQuery{}
+
+	if options.Status != "" {
+		filters.addFilter("status = %s", string(options.Status))
+	}
+
+	perPage := getPerPageValue(options.PerPage)
+	move := getMoveValue(perPage, getPageValue(options.Page))
+	query := fmt.Sprintf(selectWebhookDeliveriesScript, filters.where(), filters.addArg(perPage), filters.addArg(move))
+
+	var deliveries []*dbWebhookDelivery
+
+	err := d.reader().Select(&deliveries, query, filters.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexerDeliveries []*types.WebhookDelivery
+
+	for _, delivery := range deliveries {
+		indexerDeliveries = append(indexerDeliveries, delivery.toIndexerWebhookDelivery())
+	}
+
+	return indexerDeliveries, nil
+}
@@ -0,0 +1,190 @@
+package postgresdriver
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/pokt-foundation/pocket-indexer-lib/types"
+	"github.com/stretchr/testify/require"
+)
+
+var webhookDeliveryColumns = []string{"id", "event_type", "height", "payload", "status", "attempts",
+	"next_attempt_at", "last_error", "created_at", "delivered_at"}
+
+func TestPostgresDriver_EnqueueWebhookDeliveries(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	c.NoError(driver.EnqueueWebhookDeliveries(nil, nil))
+
+	deliveries := []*types.WebhookDelivery{
+		{EventType: types.HeightWebhookEvent, Height: 21, Payload: []byte(`{"height":21}`)},
+		{EventType: types.TransactionWebhookEvent, Height: 21, Payload: []byte(`{"hash":"ABCD"}`)},
+	}
+
+	mock.ExpectExec("INSERT into webhook_deliveries").
+		WithArgs(pq.StringArray([]string{"height", "transaction"}), pq.Int64Array([]int64{21, 21}),
+			pq.StringArray([]string{`{"height":21}`, `{"hash":"ABCD"}`})).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+
+	c.NoError(driver.EnqueueWebhookDeliveries(nil, deliveries))
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT into webhook_deliveries").WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+
+	tx, err := db.Begin()
+	c.NoError(err)
+
+	c.NoError(driver.EnqueueWebhookDeliveries(tx, deliveries))
+	c.NoError(tx.Commit())
+
+	mock.ExpectExec("INSERT into webhook_deliveries").WillReturnError(errors.New("dummy error"))
+
+	c.EqualError(driver.EnqueueWebhookDeliveries(nil, deliveries), "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ClaimWebhookDeliveries(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	now := time.Now()
+
+	rows := sqlmock.NewRows(webhookDeliveryColumns).
+		AddRow(2, "transaction", 21, []byte(`{"hash":"ABCD"}`), "pending", 2, now, "webhook status: 500", now, nil).
+		AddRow(1, "height", 21, []byte(`{"height":21}`), "pending", 1, now, nil, now, nil)
+
+	mock.ExpectQuery("UPDATE webhook_deliveries SET attempts = attempts \\+ 1(.+)FOR UPDATE SKIP LOCKED(.+)RETURNING \\*").
+		WithArgs(100, int64(60000)).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	deliveries, err := driver.ClaimWebhookDeliveries(100, time.Minute)
+	c.NoError(err)
+	c.Len(deliveries, 2)
+	c.Equal(1, deliveries[0].ID)
+	c.Equal(types.HeightWebhookEvent, deliveries[0].EventType)
+	c.Empty(deliveries[0].LastError)
+	c.Equal(2, deliveries[1].ID)
+	c.Equal(2, deliveries[1].Attempts)
+	c.Equal("webhook status: 500", deliveries[1].LastError)
+	c.True(deliveries[1].DeliveredAt.IsZero())
+
+	mock.ExpectQuery("UPDATE webhook_deliveries").WillReturnError(errors.New("dummy error"))
+
+	deliveries, err = driver.ClaimWebhookDeliveries(100, time.Minute)
+	c.EqualError(err, "dummy error")
+	c.Empty(deliveries)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_UpdateWebhookDelivery(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	now := time.Now()
+
+	delivery := &types.WebhookDelivery{
+		ID:            1,
+		Status:        types.DeliveredWebhookDelivery,
+		NextAttemptAt: now,
+		DeliveredAt:   now,
+	}
+
+	mock.ExpectExec("UPDATE webhook_deliveries SET status = (.+) WHERE id = ").
+		WithArgs("delivered", now, nil, now, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	c.NoError(driver.UpdateWebhookDelivery(delivery))
+
+	mock.ExpectExec("UPDATE webhook_deliveries").WillReturnError(errors.New("dummy error"))
+
+	c.EqualError(driver.UpdateWebhookDelivery(delivery), "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_RequeueWebhookDelivery(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	mock.ExpectExec("UPDATE webhook_deliveries SET status = 'pending'(.+)WHERE id = \\$1 AND status = 'dead'").
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	c.NoError(driver.RequeueWebhookDelivery(1))
+
+	mock.ExpectExec("UPDATE webhook_deliveries").WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	c.Equal(ErrNoDeadWebhookDelivery, driver.RequeueWebhookDelivery(2))
+
+	mock.ExpectExec("UPDATE webhook_deliveries").WithArgs(3).WillReturnError(errors.New("dummy error"))
+
+	c.EqualError(driver.RequeueWebhookDelivery(3), "dummy error")
+
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestPostgresDriver_ReadWebhookDeliveries(t *testing.T) {
+	c := require.New(t)
+
+	db, mock, err := sqlmock.New()
+	c.NoError(err)
+
+	defer db.Close()
+
+	now := time.Now()
+
+	rows := sqlmock.NewRows(webhookDeliveryColumns).
+		AddRow(3, "height", 22, []byte(`{"height":22}`), "dead", 10, now, "webhook status: 500", now, nil)
+
+	mock.ExpectQuery("^SELECT \\* FROM webhook_deliveries WHERE status = \\$1 ORDER BY id DESC(.+)LIMIT \\$2 OFFSET \\$3$").
+		WithArgs("dead", 10, 10).WillReturnRows(rows)
+
+	driver := NewPostgresDriverFromSQLDBInstance(db)
+
+	deliveries, err := driver.ReadWebhookDeliveries(&types.ReadWebhookDeliveriesOptions{
+		Page:    2,
+		PerPage: 10,
+		Status:  types.DeadWebhookDelivery,
+	})
+	c.NoError(err)
+	c.Len(deliveries, 1)
+	c.Equal(types.DeadWebhookDelivery, deliveries[0].Status)
+	c.Equal(10, deliveries[0].Attempts)
+
+	mock.ExpectQuery("^SELECT \\* FROM webhook_deliveries  ORDER BY id DESC(.+)LIMIT \\$1 OFFSET \\$2$").
+		WithArgs(1000, 0).WillReturnError(errors.New("dummy error"))
+
+	deliveries, err = driver.ReadWebhookDeliveries(nil)
+	c.EqualError(err, "dummy error")
+	c.Empty(deliveries)
+
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -4,10 +4,13 @@ import "math/big"
 
 // Account struct handler of all account fields to be indexed
 type Account struct {
-	Address             string
-	Height              int
+	Address string
+	Height  int
+	// Balance is the upokt amount of Balances, 0 when the account holds no upokt
+	// BalanceDenomination is always upokt
 	Balance             *big.Int
 	BalanceDenomination string
+	Balances            []*Coin
 }
 
 // ReadAccountByAddressOptions optional parameters for ReadAccountByAddress
@@ -15,11 +18,26 @@ type ReadAccountByAddressOptions struct {
 	Height int
 }
 
+// AccountSortField enum of the fields accounts can be sorted by
+type AccountSortField string
+
+const (
+	// AccountSortByBalance sorts accounts by balance
+	AccountSortByBalance AccountSortField = "balance"
+)
+
 // ReadAccountsOptions optional parameters for ReadAccounts
+// sorting by balance in descendant order returns the rich list, PerPage sets its length
+// when Denomination is set only accounts holding it are returned and balance sort and MinBalance use its amount
+// balance sort and MinBalance without Denomination use the upokt amount, every account holds upokt
 type ReadAccountsOptions struct {
-	PerPage int
-	Page    int
-	Height  int
+	PerPage      int
+	Page         int
+	Height       int
+	SortBy       AccountSortField
+	Order        Order
+	Denomination string
+	MinBalance   *big.Int
 }
 
 // GetAccountsQuantityOptions optional parameters for GetAccountsQuantity
@@ -0,0 +1,31 @@
+package types
+
+import "math/big"
+
+// AddressSummary struct handler of the activity of an address up to a height
+// node and app fields are only set when the address is staked as one
+type AddressSummary struct {
+	Address string
+	Height  int
+	// Balance is the upokt balance, BalanceDenomination is always upokt
+	Balance             *big.Int
+	BalanceDenomination string
+	IsNode              bool
+	NodeTokens          *big.Int
+	NodeJailed          bool
+	IsApp               bool
+	AppStakedTokens     *big.Int
+	AppJailed           bool
+	// FirstSeenHeight and LastSeenHeight are 0 when the address has no transactions
+	FirstSeenHeight  int
+	LastSeenHeight   int
+	SentQuantity     int64
+	ReceivedQuantity int64
+	// Volume is the amount of the transactions sent or received
+	Volume *big.Int
+}
+
+// ReadAddressSummaryOptions optional parameters for ReadAddressSummary
+type ReadAddressSummaryOptions struct {
+	Height int
+}
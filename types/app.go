@@ -1,6 +1,9 @@
 package types
 
-import "math/big"
+import (
+	"math/big"
+	"time"
+)
 
 // App struct handler of all app fields to be indexed
 type App struct {
@@ -9,6 +12,10 @@ type App struct {
 	Jailed       bool
 	PublicKey    string
 	StakedTokens *big.Int
+	Chains       []string
+	MaxRelays    *big.Int
+	// UnstakingTime is zero when the app is not unstaking
+	UnstakingTime time.Time
 }
 
 // ReadAppByAddressOptions optional parameters for ReadAppByAddress
@@ -16,11 +23,26 @@ type ReadAppByAddressOptions struct {
 	Height int
 }
 
+// AppSortField enum of the fields apps can be sorted by
+type AppSortField string
+
+const (
+	// AppSortByStakedTokens sorts apps by staked tokens
+	AppSortByStakedTokens AppSortField = "staked_tokens"
+)
+
 // ReadAppsOptions optional parameters for ReadApps
+// sorting by staked tokens in descendant order returns the top apps, PerPage sets its length
 type ReadAppsOptions struct {
-	PerPage int
-	Page    int
-	Height  int
+	PerPage         int
+	Page            int
+	Height          int
+	SortBy          AppSortField
+	Order           Order
+	Jailed          *bool
+	MinStakedTokens *big.Int
+	// Chain returns only the apps staked for given relay chain
+	Chain string
 }
 
 // GetAppsQuantityOptions optinal params for GetAppsQuantity
@@ -17,8 +17,33 @@ type Block struct {
 }
 
 // ReadBlocksOptions optional parameters for ReadBlocks
+// StartTime is inclusive and EndTime exclusive, zero values leave the range open
 type ReadBlocksOptions struct {
-	PerPage int
-	Page    int
-	Order   Order
+	PerPage   int
+	Page      int
+	Order     Order
+	StartTime time.Time
+	EndTime   time.Time
 }
+
+// ReadBlocksByProposerOptions optional parameters for ReadBlocksByProposer
+// heights are inclusive, zero values leave the range open
+type ReadBlocksByProposerOptions struct {
+	PerPage    int
+	Page       int
+	Order      Order
+	FromHeight int
+	ToHeight   int
+}
+
+// TimeLookup enum allows user to select which block is matched to a timestamp
+type TimeLookup string
+
+const (
+	// BeforeTimeLookup matches the last block at or before the timestamp
+	BeforeTimeLookup TimeLookup = "before"
+	// AfterTimeLookup matches the first block at or after the timestamp
+	AfterTimeLookup TimeLookup = "after"
+	// NearestTimeLookup matches the block closest to the timestamp
+	NearestTimeLookup TimeLookup = "nearest"
+)
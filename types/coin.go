@@ -0,0 +1,12 @@
+package types
+
+import "math/big"
+
+// NativeDenomination is the denomination of Pocket's native token
+const NativeDenomination = "upokt"
+
+// Coin struct handler of an amount of tokens of a denomination
+type Coin struct {
+	Denomination string
+	Amount       *big.Int
+}
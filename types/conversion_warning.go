@@ -0,0 +1,21 @@
+package types
+
+// ConversionWarning struct handler of a malformed provider field indexed as a zero value
+type ConversionWarning struct {
+	Height int
+	// Entity is the kind of the converted data: block, transaction, account, node or app
+	Entity string
+	Field  string
+	Value  string
+	// Hash is set for blocks and transactions, Address for accounts, nodes and apps
+	Hash    string
+	Address string
+	Message string
+}
+
+// ReadConversionWarningsOptions optional parameters for ReadConversionWarnings
+type ReadConversionWarningsOptions struct {
+	PerPage int
+	Page    int
+	Height  int
+}
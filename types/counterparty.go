@@ -0,0 +1,47 @@
+package types
+
+import "math/big"
+
+// FlowDirection enum of the directions of the transactions between an address and its counterparties
+type FlowDirection string
+
+const (
+	// SentFlowDirection transactions sent from the address to the counterparty
+	SentFlowDirection FlowDirection = "sent"
+	// ReceivedFlowDirection transactions received by the address from the counterparty
+	ReceivedFlowDirection FlowDirection = "received"
+)
+
+// CounterpartyFlow struct handler of the transactions aggregated between an address and a counterparty
+// Depth is the hops from the searched address, the counterparties of the searched address are depth 1
+type CounterpartyFlow struct {
+	Address              string
+	Counterparty         string
+	Direction            FlowDirection
+	Depth                int
+	TransactionsQuantity int
+	Total                *big.Int
+	FirstHeight          int
+	LastHeight           int
+}
+
+// ReadCounterpartyFlowsOptions optional parameters for ReadCounterpartyFlows
+// empty Direction returns flows in both directions, heights are inclusive
+type ReadCounterpartyFlowsOptions struct {
+	PerPage    int
+	Page       int
+	Direction  FlowDirection
+	FromHeight int
+	ToHeight   int
+}
+
+// ReadCounterpartyGraphOptions optional parameters for ReadCounterpartyGraph
+// empty Direction follows flows in both directions, heights are inclusive
+// MaxCounterparties caps the flows kept on each hop, the ones with the biggest totals are kept
+type ReadCounterpartyGraphOptions struct {
+	Depth             int
+	MaxCounterparties int
+	Direction         FlowDirection
+	FromHeight        int
+	ToHeight          int
+}
@@ -0,0 +1,13 @@
+package types
+
+// HeightData struct handler of all the values indexed for a height
+// Annotations hold values plugins share with the plugins registered after them
+type HeightData struct {
+	Height       int
+	Block        *Block
+	Transactions []*Transaction
+	Accounts     []*Account
+	Nodes        []*Node
+	Apps         []*App
+	Annotations  map[string]any
+}
@@ -0,0 +1,6 @@
+package types
+
+// HeightCommittedEvent struct handler of the event sent when all the values of a height are committed
+type HeightCommittedEvent struct {
+	Height int
+}
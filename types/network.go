@@ -0,0 +1,14 @@
+package types
+
+// Network identifies the chain being indexed
+// any other value can be used for custom chains, drivers storing each network on its own schema
+// may restrict it: the postgres driver uses it as schema when none is set, so it must then only have
+// letters, digits and underscores and not start with a digit
+type Network string
+
+const (
+	// MainnetNetwork represents Pocket's mainnet
+	MainnetNetwork Network = "mainnet"
+	// TestnetNetwork represents Pocket's testnet
+	TestnetNetwork Network = "testnet"
+)
@@ -1,15 +1,22 @@
 package types
 
-import "math/big"
+import (
+	"math/big"
+	"time"
+)
 
 // Node struct handler of all node fields to be indexed
 type Node struct {
-	Address    string
-	Height     int
-	Jailed     bool
-	PublicKey  string
-	ServiceURL string
-	Tokens     *big.Int
+	Address       string
+	Height        int
+	Jailed        bool
+	PublicKey     string
+	ServiceURL    string
+	Tokens        *big.Int
+	Chains        []string
+	OutputAddress string
+	// UnstakingTime is zero when the node is not unstaking
+	UnstakingTime time.Time
 }
 
 // ReadNodeByAddressOptions optional parameters for ReadNodeByAddress
@@ -17,11 +24,26 @@ type ReadNodeByAddressOptions struct {
 	Height int
 }
 
+// NodeSortField enum of the fields nodes can be sorted by
+type NodeSortField string
+
+const (
+	// NodeSortByTokens sorts nodes by staked tokens
+	NodeSortByTokens NodeSortField = "tokens"
+)
+
 // ReadNodesOptions optional parameters for ReadNodes
+// sorting by tokens in descendant order returns the top nodes, PerPage sets its length
 type ReadNodesOptions struct {
-	PerPage int
-	Page    int
-	Height  int
+	PerPage   int
+	Page      int
+	Height    int
+	SortBy    NodeSortField
+	Order     Order
+	Jailed    *bool
+	MinTokens *big.Int
+	// Chain returns only the nodes serving given relay chain
+	Chain string
 }
 
 // GetNodesQuantityOptions optinal params for GetNodesQuantity
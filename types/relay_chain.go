@@ -0,0 +1,29 @@
+package types
+
+import "math/big"
+
+// RelayChainStats struct handler of the nodes and apps staked for a relay chain at a height
+type RelayChainStats struct {
+	Chain             string
+	NodesQuantity     int
+	AppsQuantity      int
+	NodesStakedTokens *big.Int
+	AppsStakedTokens  *big.Int
+}
+
+// RelayChainStakers struct handler of the addresses of the nodes and apps staked for a relay chain at a height
+type RelayChainStakers struct {
+	Chain         string
+	NodeAddresses []string
+	AppAddresses  []string
+}
+
+// ReadRelayChainStatsOptions optional parameters for ReadRelayChainStats
+type ReadRelayChainStatsOptions struct {
+	Height int
+}
+
+// ReadRelayChainStakersOptions optional parameters for ReadRelayChainStakers
+type ReadRelayChainStakersOptions struct {
+	Height int
+}
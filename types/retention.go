@@ -0,0 +1,32 @@
+package types
+
+// RetentionEntity enum of the per height snapshot entities that can be pruned
+type RetentionEntity string
+
+const (
+	// AccountsRetentionEntity represents accounts snapshots
+	AccountsRetentionEntity RetentionEntity = "accounts"
+	// NodesRetentionEntity represents nodes snapshots
+	NodesRetentionEntity RetentionEntity = "nodes"
+	// AppsRetentionEntity represents apps snapshots
+	AppsRetentionEntity RetentionEntity = "apps"
+)
+
+// Retention struct handler of the snapshots to keep for an entity
+// zero value keeps every snapshot
+type Retention struct {
+	// KeepLastHeights keeps every snapshot of the last N heights, latest height is always kept
+	KeepLastHeights int
+	// KeepEveryHeight keeps snapshots of heights multiple of K older than the last heights
+	KeepEveryHeight int
+}
+
+// RetentionPolicy struct handler of the retention for all snapshot entities
+type RetentionPolicy struct {
+	// Default retention for entities not set on Entities
+	Default Retention
+	// Entities overrides default retention per entity
+	Entities map[RetentionEntity]Retention
+	// BatchSize is the max quantity of rows deleted per statement
+	BatchSize int
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"math/big"
+	"time"
+)
+
+// StatsInterval enum allows user to select the size of the buckets stats are grouped in
+type StatsInterval string
+
+const (
+	// HourlyStatsInterval groups stats by hour
+	HourlyStatsInterval StatsInterval = "hourly"
+	// DailyStatsInterval groups stats by day
+	DailyStatsInterval StatsInterval = "daily"
+)
+
+// BlockTimeStats struct handler of block time and throughput stats over a range of blocks
+// block time stats skip blocks without took calculated
+type BlockTimeStats struct {
+	// BucketStart is the start of the bucket, only set on bucketed stats
+	BucketStart                 time.Time
+	StartHeight                 int
+	EndHeight                   int
+	StartTime                   time.Time
+	EndTime                     time.Time
+	BlocksQuantity              int
+	AverageBlockTime            time.Duration
+	MinBlockTime                time.Duration
+	MaxBlockTime                time.Duration
+	MedianBlockTime             time.Duration
+	P90BlockTime                time.Duration
+	P99BlockTime                time.Duration
+	TransactionsQuantity        int
+	AverageTransactionsPerBlock float64
+	TransactionsPerSecond       float64
+}
+
+// ReadBlockTimeStatsOptions optional parameters for ReadBlockTimeStats and ReadBlockTimeStatsBuckets
+// heights are inclusive, StartTime is inclusive and EndTime exclusive, zero values leave the range open
+type ReadBlockTimeStatsOptions struct {
+	FromHeight int
+	ToHeight   int
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+// ProposerStats struct handler of the blocks proposed by a validator over a range of blocks
+type ProposerStats struct {
+	Address        string
+	BlocksProposed int
+	FirstHeight    int
+	LastHeight     int
+	// Share is the fraction of the blocks in range proposed by the validator
+	Share float64
+}
+
+// ReadProposerStatsOptions optional parameters for ReadProposerStats
+// heights are inclusive, StartTime is inclusive and EndTime exclusive, zero values leave the range open
+type ReadProposerStatsOptions struct {
+	PerPage    int
+	Page       int
+	FromHeight int
+	ToHeight   int
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+// NetworkStats struct handler of the network activity aggregated over a time bucket
+type NetworkStats struct {
+	BucketStart          time.Time
+	StartHeight          int
+	EndHeight            int
+	BlocksQuantity       int
+	TransactionsQuantity int
+	// Fees is the sum of the upokt fees, fees paid in other denominations are not counted
+	Fees   *big.Int
+	Volume *big.Int
+	// ActiveAccounts is the quantity of distinct addresses sending or receiving transactions
+	ActiveAccounts int
+}
+
+// ReadNetworkStatsOptions optional parameters for ReadNetworkStats
+// StartTime is inclusive and EndTime exclusive, zero values leave the range open
+type ReadNetworkStatsOptions struct {
+	PerPage   int
+	Page      int
+	Order     Order
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// FeeStats struct handler of the fees paid by the transactions of a message type in a denomination
+type FeeStats struct {
+	// BucketStart is the start of the bucket, only set when an interval is given
+	BucketStart          time.Time
+	MessageType          string
+	Denomination         string
+	TransactionsQuantity int
+	Total                *big.Int
+	// Average is rounded down to an integer amount
+	Average *big.Int
+}
+
+// ReadFeeStatsOptions optional parameters for ReadFeeStats
+// heights are inclusive, StartTime is inclusive and EndTime exclusive, zero values leave the range open
+// stats are grouped in buckets of Interval when set
+type ReadFeeStatsOptions struct {
+	PerPage      int
+	Page         int
+	Interval     StatsInterval
+	MessageType  string
+	Denomination string
+	FromHeight   int
+	ToHeight     int
+	StartTime    time.Time
+	EndTime      time.Time
+}
@@ -0,0 +1,28 @@
+package types
+
+import "math/big"
+
+// Supply struct handler of the tokens supply at a height
+type Supply struct {
+	Height int
+	// Liquid is the sum of the accounts upokt balances, other denominations are not counted
+	Liquid      *big.Int
+	NodesStaked *big.Int
+	AppsStaked  *big.Int
+	// JailedStaked is the stake of jailed nodes and apps, it is included on NodesStaked and AppsStaked
+	JailedStaked *big.Int
+	// Staked is the stake of nodes and apps
+	Staked *big.Int
+	// Total is the liquid and staked supply
+	Total *big.Int
+}
+
+// ReadSupplyHistoryOptions optional parameters for ReadSupplyHistory
+// heights are inclusive, zero values are not set
+type ReadSupplyHistoryOptions struct {
+	PerPage    int
+	Page       int
+	Order      Order
+	FromHeight int
+	ToHeight   int
+}
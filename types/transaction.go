@@ -8,21 +8,29 @@ import (
 
 // Transaction struct handler of all transaction fields to be indexed
 type Transaction struct {
-	Hash            string
-	FromAddress     string
-	ToAddress       string
-	AppPubKey       string
-	Blockchains     []string
-	MessageType     string
-	Height          int
-	Index           int
-	StdTx           *provider.StdTx
-	TxResult        *provider.TxResult
-	Tx              string
-	Entropy         int
-	Fee             int
+	Hash        string
+	FromAddress string
+	ToAddress   string
+	AppPubKey   string
+	Blockchains []string
+	MessageType string
+	Height      int
+	Index       int
+	StdTx       *provider.StdTx
+	TxResult    *provider.TxResult
+	Tx          string
+	Entropy     int
+	// Fee and FeeDenomination are the first coin of Fees
+	Fee             *big.Int
 	FeeDenomination string
+	Fees            []*Coin
 	Amount          *big.Int
+	Memo            string
+	// SignerAddress is the address of AppPubKey
+	SignerAddress string
+	// Signature is the signature of the StdTx, the signer public key is AppPubKey
+	Signature     string
+	ProofRootHash string
 }
 
 // ReadTransactionsOptions optional parameters for ReadTransactions
@@ -33,9 +41,17 @@ type ReadTransactionsOptions struct {
 }
 
 // ReadTransactionsByAddressOptions optional parameters for ReadTransactionsByAddress
+// IncludeSigner also matches the transactions signed by the address
 type ReadTransactionsByAddressOptions struct {
-	PerPage int
-	Page    int
+	PerPage       int
+	Page          int
+	IncludeSigner bool
+}
+
+// GetTransactionsQuantityByAddressOptions optional parameters for GetTransactionsQuantityByAddressWithOptions
+// IncludeSigner also counts the transactions signed by the address
+type GetTransactionsQuantityByAddressOptions struct {
+	IncludeSigner bool
 }
 
 // ReadTransactionsByHeightOptions optional parameters for ReadTransactionsByHeight
@@ -43,3 +59,20 @@ type ReadTransactionsByHeightOptions struct {
 	PerPage int
 	Page    int
 }
+
+// ReadTransactionsByBlockchainOptions optional parameters for ReadTransactionsByBlockchain
+type ReadTransactionsByBlockchainOptions struct {
+	PerPage int
+	Page    int
+	Order   Order
+}
+
+// ReadTransactionsByMemoOptions optional parameters for ReadTransactionsByMemo
+// Prefix matches memos starting with the given text instead of memos equal to it
+// substring search is not supported as it can not use an index
+type ReadTransactionsByMemoOptions struct {
+	PerPage int
+	Page    int
+	Order   Order
+	Prefix  bool
+}
@@ -0,0 +1,47 @@
+package types
+
+import "time"
+
+// WebhookEventType enum of the events pushed to webhooks
+type WebhookEventType string
+
+const (
+	// HeightWebhookEvent event of an indexed height
+	HeightWebhookEvent WebhookEventType = "height"
+	// TransactionWebhookEvent event of an indexed transaction
+	TransactionWebhookEvent WebhookEventType = "transaction"
+)
+
+// WebhookDeliveryStatus enum of the states of a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	// PendingWebhookDelivery delivery waiting to be sent or retried
+	PendingWebhookDelivery WebhookDeliveryStatus = "pending"
+	// DeliveredWebhookDelivery delivery accepted by the webhook
+	DeliveredWebhookDelivery WebhookDeliveryStatus = "delivered"
+	// DeadWebhookDelivery delivery that ran out of attempts
+	DeadWebhookDelivery WebhookDeliveryStatus = "dead"
+)
+
+// WebhookDelivery struct handler of a webhook payload in the outbox
+// Payload is the JSON body posted to the webhook
+type WebhookDelivery struct {
+	ID            int
+	EventType     WebhookEventType
+	Height        int
+	Payload       []byte
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	DeliveredAt   time.Time
+}
+
+// ReadWebhookDeliveriesOptions optional parameters for ReadWebhookDeliveries
+type ReadWebhookDeliveriesOptions struct {
+	PerPage int
+	Page    int
+	Status  WebhookDeliveryStatus
+}